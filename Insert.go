@@ -1,10 +1,5 @@
 package fluentsql
 
-import (
-	"fmt"
-	"strings"
-)
-
 // Insert clause represents an SQL INSERT statement with a table name and columns.
 // Cases:
 // INSERT INTO Customers (CustomerName, ContactName, Address, City, PostalCode, Country)
@@ -27,7 +22,5 @@ type Insert struct {
 // It joins the Columns slice with commas and formats it into the SQL syntax.
 // Returns: A string representation of the SQL INSERT statement.
 func (i *Insert) String() string {
-	columnsStr := strings.Join(i.Columns, ", ") // Joins the column names with commas.
-
-	return fmt.Sprintf("INSERT INTO %s (%s)", i.Table, columnsStr) // Formats the final SQL string.
+	return inlineString(i)
 }
@@ -12,11 +12,5 @@ type InsertQuery struct {
 //   - string: The string representation of the query. If the Query is a QueryBuilder,
 //     it calls the QueryBuilder's String method; otherwise, it returns an empty string.
 func (q *InsertQuery) String() string {
-	if queryBuilder, ok := q.Query.(*QueryBuilder); ok {
-		// Call the String method of QueryBuilder if Query is of type *QueryBuilder.
-		return queryBuilder.String()
-	}
-
-	// Return an empty string if Query is not of type *QueryBuilder.
-	return ""
+	return inlineString(q)
 }
@@ -1,10 +1,5 @@
 package fluentsql
 
-import (
-	"fmt"
-	"strings"
-)
-
 type Case struct {
 	// Exp specifies the expression to be evaluated in the CASE statement.
 	Exp string
@@ -55,11 +50,11 @@ func FieldCase(exp, name string) *Case {
 //
 // Parameters:
 //   - conditions: The condition(s) to evaluate (can be a single value, string, or a slice of Condition).
-//   - value: The value to return when the condition is met.
+//   - value: The value to return when the condition is met (a string, number, ValueField, Expression...).
 //
 // Returns:
 //   - *Case: A pointer to the Case instance, for method chaining.
-func (c *Case) When(conditions any, value string) *Case {
+func (c *Case) When(conditions any, value any) *Case {
 	c.WhenClauses = append(c.WhenClauses, WhenCase{
 		Conditions: conditions,
 		Value:      value,
@@ -68,11 +63,39 @@ func (c *Case) When(conditions any, value string) *Case {
 	return c
 }
 
+// Clone returns a deep copy of the Case instance, including the conditions and values of the WHEN clauses.
+//
+// Returns:
+//   - *Case: A pointer to the new Case instance.
+func (c *Case) Clone() *Case {
+	if c == nil {
+		return nil
+	}
+
+	cloned := &Case{
+		Exp:  c.Exp,
+		Name: c.Name,
+	}
+
+	if c.WhenClauses != nil {
+		cloned.WhenClauses = make([]WhenCase, len(c.WhenClauses))
+		for i, when := range c.WhenClauses {
+			cloned.WhenClauses[i] = WhenCase{
+				Conditions: cloneValue(when.Conditions),
+				Value:      cloneValue(when.Value),
+			}
+		}
+	}
+
+	return cloned
+}
+
 type WhenCase struct {
 	// Conditions represents the condition(s) evaluated in the WHEN clause. It can be a string, integer, or slice of Condition.
 	Conditions any
 	// Value represents the result to return when the conditions are met.
-	Value string
+	// Strings are quoted, ValueField and Expression are kept as SQL.
+	Value any
 }
 
 // String generates the SQL representation of the WHEN clause.
@@ -80,16 +103,7 @@ type WhenCase struct {
 // Returns:
 //   - string: The SQL string of the WHEN clause.
 func (c *WhenCase) String() string {
-	if valueConditions, ok := c.Conditions.([]Condition); ok {
-		var cons []string
-		for _, condition := range valueConditions {
-			cons = append(cons, condition.String())
-		}
-
-		return fmt.Sprintf("WHEN %s THEN '%s'", strings.Join(cons, " AND "), c.Value)
-	}
-
-	return fmt.Sprintf("WHEN %v THEN '%s'", c.Conditions, c.Value)
+	return inlineString(c)
 }
 
 // String generates the SQL representation of the entire CASE statement.
@@ -97,11 +111,31 @@ func (c *WhenCase) String() string {
 // Returns:
 //   - string: The SQL string of the CASE statement.
 func (c *Case) String() string {
-	var whenCases []string
+	return inlineString(c)
+}
+
+// simpleWhenClauses returns the WHEN clauses where the compared values of a simple CASE expression
+// are wrapped into an Expression, so that strings are quoted and values are bound as arguments.
+// The WHEN clauses of a search CASE expression (no Exp) are returned as is.
+//
+// Returns:
+//   - []WhenCase: The WHEN clauses to render.
+func (c *Case) simpleWhenClauses() []WhenCase {
+	if c.Exp == "" {
+		return c.WhenClauses
+	}
+
+	whenClauses := make([]WhenCase, 0, len(c.WhenClauses))
 
 	for _, whenClause := range c.WhenClauses {
-		whenCases = append(whenCases, whenClause.String())
+		switch whenClause.Conditions.(type) {
+		case []Condition, Expression, ValueField:
+		default:
+			whenClause.Conditions = Expr(question, whenClause.Conditions)
+		}
+
+		whenClauses = append(whenClauses, whenClause)
 	}
 
-	return fmt.Sprintf("CASE %s %s END %s", c.Exp, strings.Join(whenCases, " "), c.Name)
+	return whenClauses
 }
@@ -0,0 +1,208 @@
+package fluentsql
+
+import "reflect"
+
+// ====================================================================
+//                   Clone :: Clauses
+// ====================================================================
+
+// clone returns a deep copy of the SELECT clause.
+func (s Select) clone() Select {
+	return Select{Columns: cloneValues(s.Columns)}
+}
+
+// clone returns a deep copy of the FROM clause, a nested query is cloned too.
+func (f From) clone() From {
+	return From{
+		Table: cloneValue(f.Table),
+		Alias: f.Alias,
+	}
+}
+
+// clone returns a deep copy of the JOIN clauses.
+func (j Join) clone() Join {
+	if j.Items == nil {
+		return Join{}
+	}
+
+	items := make([]JoinItem, len(j.Items))
+	for i, item := range j.Items {
+		items[i] = JoinItem{
+			Join:      item.Join,
+			Table:     item.Table,
+			Condition: item.Condition.clone(),
+		}
+	}
+
+	return Join{Items: items}
+}
+
+// clone returns a deep copy of the WHERE clause.
+func (w Where) clone() Where {
+	return Where{Conditions: cloneConditions(w.Conditions)}
+}
+
+// clone returns a deep copy of the HAVING clause.
+func (w Having) clone() Having {
+	return Having{Where: w.Where.clone()}
+}
+
+// clone returns a deep copy of the GROUP BY clause.
+func (g GroupBy) clone() GroupBy {
+	return GroupBy{Items: cloneSlice(g.Items)}
+}
+
+// clone returns a deep copy of the ORDER BY clause.
+func (o OrderBy) clone() OrderBy {
+	return OrderBy{Items: cloneSlice(o.Items)}
+}
+
+// clone returns a deep copy of the condition, its field, value and group included.
+func (c Condition) clone() Condition {
+	return Condition{
+		Field: cloneValue(c.Field),
+		Opt:   c.Opt,
+		Value: cloneValue(c.Value),
+		AndOr: c.AndOr,
+		Group: cloneConditions(c.Group),
+	}
+}
+
+// clone returns a deep copy of the INSERT clause.
+func (i Insert) clone() Insert {
+	return Insert{
+		Table:   i.Table,
+		Columns: cloneSlice(i.Columns),
+	}
+}
+
+// clone returns a deep copy of the VALUES rows.
+func (r InsertRows) clone() InsertRows {
+	if r.Rows == nil {
+		return InsertRows{}
+	}
+
+	rows := make([]InsertRow, len(r.Rows))
+	for i, row := range r.Rows {
+		rows[i] = InsertRow{Values: cloneValues(row.Values)}
+	}
+
+	return InsertRows{Rows: rows}
+}
+
+// clone returns a deep copy of the SET assignments.
+func (s UpdateSet) clone() UpdateSet {
+	if s.Items == nil {
+		return UpdateSet{}
+	}
+
+	items := make([]UpdateItem, len(s.Items))
+	for i, item := range s.Items {
+		items[i] = UpdateItem{
+			Field: cloneValue(item.Field),
+			Value: cloneValue(item.Value),
+		}
+	}
+
+	return UpdateSet{Items: items}
+}
+
+// ====================================================================
+//                   Clone :: Values
+// ====================================================================
+
+// cloneConditions returns a deep copy of a list of conditions.
+//
+// Parameters:
+//   - conditions: The conditions to copy.
+//
+// Returns:
+//   - []Condition: The copy, nil for a nil list.
+func cloneConditions(conditions []Condition) []Condition {
+	if conditions == nil {
+		return nil
+	}
+
+	cloned := make([]Condition, len(conditions))
+	for i, condition := range conditions {
+		cloned[i] = condition.clone()
+	}
+
+	return cloned
+}
+
+// cloneValues returns a deep copy of a list of values, see cloneValue.
+//
+// Parameters:
+//   - values: The values to copy.
+//
+// Returns:
+//   - []any: The copy, nil for a nil list.
+func cloneValues(values []any) []any {
+	if values == nil {
+		return nil
+	}
+
+	cloned := make([]any, len(values))
+	for i, value := range values {
+		cloned[i] = cloneValue(value)
+	}
+
+	return cloned
+}
+
+// cloneSlice returns a shallow copy of a slice of plain values.
+//
+// Parameters:
+//   - items: The slice to copy.
+//
+// Returns:
+//   - []T: The copy, nil for a nil slice.
+func cloneSlice[T any](items []T) []T {
+	if items == nil {
+		return nil
+	}
+
+	return append(make([]T, 0, len(items)), items...)
+}
+
+// cloneValue returns a deep copy of a value used in a clause. Nested queries, CASE expressions,
+// expressions, conditions and slices are copied, other values are returned as they are.
+//
+// Parameters:
+//   - value: The value to copy.
+//
+// Returns:
+//   - any: The copy.
+func cloneValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case *QueryBuilder:
+		return v.Clone()
+	case *Case:
+		return v.Clone()
+	case Case:
+		return *v.Clone()
+	case Expression:
+		return Expression{SQL: v.SQL, Args: cloneValues(v.Args)}
+	case Condition:
+		return v.clone()
+	case []Condition:
+		return cloneConditions(v)
+	case []any:
+		return cloneValues(v)
+	case ValueBetween:
+		return ValueBetween{Low: cloneValue(v.Low), High: cloneValue(v.High)}
+	}
+
+	// Copy the other slices such as []int or []string
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice && !rv.IsNil() {
+		cloned := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(cloned, rv)
+
+		return cloned.Interface()
+	}
+
+	return value
+}
@@ -0,0 +1,96 @@
+package fluentsql
+
+import (
+	"testing"
+)
+
+// TestQueryClone
+func TestQueryClone(t *testing.T) {
+	ageGroup := &Case{Name: "age_group"}
+	ageGroup.When([]Condition{{Field: "age", Opt: Lesser, Value: 18}}, "minor").
+		When([]Condition{{Field: "age", Opt: GrEq, Value: 18}}, "adult")
+
+	base := QueryInstance().
+		Select("id", "name", ageGroup).
+		From("users", "u").
+		Join(InnerJoin, "companies c", Condition{Field: "c.id", Opt: Eq, Value: ValueField("u.company_id")}).
+		Where("status", Eq, "active").
+		Where("id", In, []int{1, 2, 3}).
+		WhereGroup(func(whereBuilder WhereBuilder) *WhereBuilder {
+			whereBuilder.Where("role", Eq, "admin").
+				WhereOr("role", Eq, "owner")
+
+			return &whereBuilder
+		}).
+		Where("company_id", In, QueryInstance().
+			Select("id").
+			From("companies").
+			Where("country", Eq, "VN")).
+		OrderBy("name", Asc)
+	expected := base.String()
+
+	clone := base.Clone()
+	if clone.String() != expected {
+		t.Fatalf(`Query %s != %s`, clone.String(), expected)
+	}
+
+	clone.selectStatement.Columns[2].(*Case).WhenClauses[0].Value = "child"
+	clone.whereStatement.Conditions[1].Value.([]int)[0] = 9
+	clone.whereStatement.Conditions[2].Group[0].Value = "guest"
+	clone.whereStatement.Conditions[3].Value.(*QueryBuilder).Where("active", Eq, true)
+	clone.joinStatement.Items[0].Table = "accounts c"
+	clone.Select("email").
+		Where("age", Greater, 30).
+		OrderBy("id", Desc).
+		Limit(10, 0)
+
+	if base.String() != expected {
+		t.Fatalf(`Query %s != %s`, base.String(), expected)
+	}
+}
+
+// TestBuildersClone
+func TestBuildersClone(t *testing.T) {
+	insert := InsertInstance().
+		Insert("users", "name", "age").
+		Row("John", 30)
+	insertSql := insert.String()
+
+	insert.Clone().Row("Jane", 25)
+
+	if insert.String() != insertSql {
+		t.Fatalf(`Query %s != %s`, insert.String(), insertSql)
+	}
+
+	update := UpdateInstance().
+		Update("users").
+		Set("name", "John").
+		Set("tags", Expr("array_append(tags, ?)", "new")).
+		Where("id", Eq, 1)
+	updateSql := update.String()
+
+	updateClone := update.Clone().Set("age", 30).Where("status", Eq, "active")
+	updateClone.setStatement.Items[1].Value.(Expression).Args[0] = "old"
+
+	if update.String() != updateSql {
+		t.Fatalf(`Query %s != %s`, update.String(), updateSql)
+	}
+
+	del := DeleteInstance().
+		Delete("users").
+		Where("id", Eq, 1)
+	deleteSql := del.String()
+
+	del.Clone().Where("status", Eq, "inactive")
+
+	if del.String() != deleteSql {
+		t.Fatalf(`Query %s != %s`, del.String(), deleteSql)
+	}
+
+	where := WhereInstance().Where("id", Eq, 1)
+	where.Clone().Where("status", Eq, "active")
+
+	if len(where.Conditions()) != 1 {
+		t.Fatalf(`Conditions %v`, where.Conditions())
+	}
+}
@@ -0,0 +1,235 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// ====================================================================
+//                   Compiled :: Structure
+// ====================================================================
+
+// Compiled is a statement generated once by Compile, for the hot paths running the same shape with
+// different values. The SQL and the fixed arguments are cached, the Param slots are filled by Args
+// without walking the clauses again. It is immutable and safe for concurrent use.
+//
+// The placeholders are those of the dialect at compile time.
+type Compiled struct {
+	stmt   Statement // stmt is the generated statement, its arguments hold the Param slots.
+	slots  []int     // slots are the indexes of the Param slots in the arguments.
+	params []string  // params are the distinct names of the parameters, in order of appearance.
+}
+
+// ====================================================================
+//                   Compiled :: Operators
+// ====================================================================
+
+// Compile generates the SELECT statement once.
+//
+// Returns:
+//   - *Compiled: The compiled statement.
+//   - error: The error of the statement generation.
+//
+// Example:
+//
+//	byEmail, err := fluentsql.QueryInstance().
+//	    Select("*").
+//	    From("users").
+//	    Where("email", fluentsql.Eq, fluentsql.Param("email")).
+//	    Compile()
+//
+//	row := byEmail.QueryRowContext(ctx, db, map[string]any{"email": email})
+func (qb *QueryBuilder) Compile() (*Compiled, error) {
+	sqlStr, args, err := qb.Sql()
+	if err != nil {
+		return nil, err
+	}
+
+	return newCompiled(qb.statement(sqlStr, args)), nil
+}
+
+// Compile generates the INSERT statement once.
+//
+// Returns:
+//   - *Compiled: The compiled statement.
+//   - error: The error of the statement generation.
+func (ib *InsertBuilder) Compile() (*Compiled, error) {
+	sqlStr, args, err := ib.Sql()
+	if err != nil {
+		return nil, err
+	}
+
+	return newCompiled(ib.statement(sqlStr, args)), nil
+}
+
+// Compile generates the UPDATE statement once.
+//
+// Returns:
+//   - *Compiled: The compiled statement.
+//   - error: The error of the statement generation, e.g. ErrMissingWhere.
+func (ub *UpdateBuilder) Compile() (*Compiled, error) {
+	sqlStr, args, err := ub.Sql()
+	if err != nil {
+		return nil, err
+	}
+
+	return newCompiled(ub.statement(sqlStr, args)), nil
+}
+
+// Compile generates the DELETE statement once.
+//
+// Returns:
+//   - *Compiled: The compiled statement.
+//   - error: The error of the statement generation, e.g. ErrMissingWhere.
+func (db *DeleteBuilder) Compile() (*Compiled, error) {
+	sqlStr, args, err := db.Sql()
+	if err != nil {
+		return nil, err
+	}
+
+	return newCompiled(db.statement(sqlStr, args)), nil
+}
+
+// newCompiled indexes the Param slots of a statement.
+//
+// Parameters:
+//   - stmt (Statement): The generated statement.
+//
+// Returns:
+//   - *Compiled: The compiled statement.
+func newCompiled(stmt Statement) *Compiled {
+	compiled := &Compiled{stmt: stmt}
+
+	seen := make(map[Param]bool)
+
+	for i, arg := range stmt.Args {
+		param, ok := arg.(Param)
+		if !ok {
+			continue
+		}
+
+		compiled.slots = append(compiled.slots, i)
+
+		if !seen[param] {
+			seen[param] = true
+			compiled.params = append(compiled.params, string(param))
+		}
+	}
+
+	return compiled
+}
+
+// SQL returns the generated statement.
+//
+// Returns:
+//   - string: The statement with the placeholders of the dialect.
+func (c *Compiled) SQL() string {
+	return c.stmt.SQL
+}
+
+// Params returns the names of the parameters.
+//
+// Returns:
+//   - []string: The distinct names of the Param slots, in order of appearance.
+func (c *Compiled) Params() []string {
+	return append([]string(nil), c.params...)
+}
+
+// Args returns the arguments of the statement with the Param slots filled.
+//
+// Parameters:
+//   - values (map[string]any): The values of the parameters, by name.
+//
+// Returns:
+//   - []any: The arguments in placeholder order.
+//   - error: ErrMissingParam if a parameter has no value.
+func (c *Compiled) Args(values map[string]any) ([]any, error) {
+	args := make([]any, len(c.stmt.Args))
+	copy(args, c.stmt.Args)
+
+	for _, i := range c.slots {
+		param := args[i].(Param)
+
+		value, ok := values[string(param)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingParam, param)
+		}
+
+		args[i] = value
+	}
+
+	return args, nil
+}
+
+// ExecContext runs the statement with the values of its parameters without returning rows.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor.
+//   - values (map[string]any): The values of the parameters, by name.
+//
+// Returns:
+//   - sql.Result: The result of the statement.
+//   - error: ErrScopeViolation, ErrMissingParam or the driver error wrapped in a QueryError.
+func (c *Compiled) ExecContext(ctx context.Context, runner Runner, values map[string]any) (sql.Result, error) {
+	stmt, err := c.statement(ctx, runner, values)
+
+	return execContext(ctx, runner, stmt, err)
+}
+
+// QueryContext runs the statement with the values of its parameters.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor.
+//   - values (map[string]any): The values of the parameters, by name.
+//
+// Returns:
+//   - *sql.Rows: The rows of the result, to be closed by the caller.
+//   - error: ErrScopeViolation, ErrMissingParam or the driver error wrapped in a QueryError.
+func (c *Compiled) QueryContext(ctx context.Context, runner Runner, values map[string]any) (*sql.Rows, error) {
+	stmt, err := c.statement(ctx, runner, values)
+
+	return queryContext(ctx, runner, stmt, err)
+}
+
+// QueryRowContext runs the statement with the values of its parameters, expecting at most one row.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor.
+//   - values (map[string]any): The values of the parameters, by name.
+//
+// Returns:
+//   - *Row: The row, its Scan method reports the errors.
+func (c *Compiled) QueryRowContext(ctx context.Context, runner Runner, values map[string]any) *Row {
+	stmt, err := c.statement(ctx, runner, values)
+
+	return queryRowContext(ctx, runner, stmt, err)
+}
+
+// statement returns the statement with the values of its parameters. The scopes cannot be added
+// to a compiled statement, it is rejected when the executor or the context has scopes, unless the
+// builder was compiled Unscoped.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The runner of the statement.
+//   - values (map[string]any): The values of the parameters, by name.
+//
+// Returns:
+//   - Statement: The statement with its bound arguments.
+//   - error: ErrScopeViolation for a scoped run, or ErrMissingParam if a parameter has no value.
+func (c *Compiled) statement(ctx context.Context, runner Runner, values map[string]any) (Statement, error) {
+	if !c.stmt.Unscoped && len(scopesOf(ctx, runner)) > 0 {
+		return c.stmt, fmt.Errorf("%w: compiled statement run with scopes, apply them before Compile and call Unscoped", ErrScopeViolation)
+	}
+
+	args, err := c.Args(values)
+
+	stmt := c.stmt
+	stmt.Args = args
+
+	return stmt, err
+}
@@ -0,0 +1,130 @@
+package fluentsql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// compileQuery is the query of the Compile tests and benchmarks.
+func compileQuery(id any, status any) *QueryBuilder {
+	return QueryInstance().
+		Select("id", "name", "email").
+		From("users", "u").
+		Join(LeftJoin, "orders o", Condition{Field: "o.user_id", Opt: Eq, Value: ValueField("u.id")}).
+		Where("u.id", Eq, id).
+		Where("u.status", In, []any{status, "active"}).
+		Where("u.deleted_at", Null, nil).
+		OrderBy("u.id", Asc).
+		Limit(10, 0)
+}
+
+// TestCompile
+func TestCompile(t *testing.T) {
+	compiled, err := compileQuery(Param("id"), Param("status")).Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, _, _ := compileQuery(1, "new").Sql()
+	if compiled.SQL() != expected {
+		t.Fatalf(`Query %s != %s`, compiled.SQL(), expected)
+	}
+
+	if params := compiled.Params(); !reflect.DeepEqual(params, []string{"id", "status"}) {
+		t.Fatalf(`Params %v`, params)
+	}
+
+	args, err := compiled.Args(map[string]any{"id": 1, "status": "new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, expectedArgs, _ := compileQuery(1, "new").Sql()
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Fatalf(`Args %v != %v`, args, expectedArgs)
+	}
+
+	if _, err = compiled.Args(map[string]any{"id": 1}); !errors.Is(err, ErrMissingParam) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingParam)
+	}
+
+	if _, err = UpdateInstance().Update("users").Set("name", Param("name")).Compile(); !errors.Is(err, ErrMissingWhere) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingWhere)
+	}
+}
+
+// TestCompiledExecContext
+func TestCompiledExecContext(t *testing.T) {
+	db, fake := newFakeDB(t, func(string, []any) fakeResult {
+		return fakeResult{RowsAffected: 1}
+	})
+
+	compiled, err := UpdateInstance().
+		Update("users").
+		Set("name", Param("name")).
+		Where("id", Eq, Param("id")).
+		Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id, name := range map[int]string{1: "John", 2: "Jane"} {
+		if _, err = compiled.ExecContext(context.Background(), db, map[string]any{"id": id, "name": name}); err != nil {
+			t.Fatal(err)
+		}
+
+		last := fake.Last()
+		if last.SQL != "UPDATE users SET name = $1 WHERE id = $2" || !reflect.DeepEqual(last.Args, []any{name, int64(id)}) {
+			t.Fatalf(`Query %s (%v)`, last.SQL, last.Args)
+		}
+	}
+
+	if _, err = compiled.ExecContext(context.Background(), db, nil); !errors.Is(err, ErrMissingParam) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingParam)
+	}
+}
+
+// TestCompileAllocations
+func TestCompileAllocations(t *testing.T) {
+	compiled, _ := compileQuery(Param("id"), Param("status")).Compile()
+	values := map[string]any{"id": 1, "status": "new"}
+
+	sqlAllocs := testing.AllocsPerRun(100, func() {
+		_, _, _ = compileQuery(1, "new").Sql()
+	})
+
+	compiledAllocs := testing.AllocsPerRun(100, func() {
+		_, _ = compiled.Args(values)
+	})
+
+	if compiledAllocs >= sqlAllocs {
+		t.Fatalf(`Compiled allocations %v >= Sql allocations %v`, compiledAllocs, sqlAllocs)
+	}
+}
+
+// BenchmarkSql
+func BenchmarkSql(b *testing.B) {
+	query := compileQuery(1, "new")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = query.Sql()
+	}
+}
+
+// BenchmarkCompiledArgs
+func BenchmarkCompiledArgs(b *testing.B) {
+	compiled, _ := compileQuery(Param("id"), Param("status")).Compile()
+	values := map[string]any{"id": 1, "status": "new"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = compiled.Args(values)
+	}
+}
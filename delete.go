@@ -2,7 +2,6 @@ package fluentsql
 
 import (
 	"fmt"
-	"strings"
 )
 
 // Delete clause
@@ -17,12 +16,17 @@ type Delete struct {
 // Returns:
 //   - A string representing the DELETE SQL query.
 func (u *Delete) String() string {
-	var sb strings.Builder // sb is a string builder used to efficiently construct the query string
-	sb.WriteString(fmt.Sprintf("DELETE FROM %s", u.Table))
+	return inlineString(u)
+}
 
+// target returns the table reference which rows are deleted from in a multi-table DELETE.
+//
+// Returns:
+//   - string: The alias if present, otherwise the table name.
+func (u *Delete) target() string {
 	if u.Alias != "" {
-		sb.WriteString(" " + u.Alias)
+		return u.Alias
 	}
 
-	return sb.String()
+	return fmt.Sprintf("%s", u.Table)
 }
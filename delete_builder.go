@@ -1,7 +1,5 @@
 package fluentsql
 
-import "strings"
-
 // ====================================================================
 //                   Delete Builder :: Structure
 // ====================================================================
@@ -16,12 +14,24 @@ import "strings"
 //	[ORDER BY ...]
 //	[LIMIT row_count]
 //
+//	DELETE [LOW_PRIORITY] [QUICK] [IGNORE] tbl_alias FROM table_references
+//	[WHERE where_condition]
+//
+//	DELETE FROM tbl_name [[AS] tbl_alias]
+//	[USING from_item [, ...]]
+//	[WHERE where_condition]
+//
 // It defines the components of the DELETE query.
 type DeleteBuilder struct {
-	deleteStatement  Delete  // Defines the DELETE clause for specifying the table and optional alias
-	whereStatement   Where   // Stores conditions for the WHERE clause
-	orderByStatement OrderBy // Represents sorting conditions for the ORDER BY clause
-	limitStatement   Limit   // Specifies the LIMIT and OFFSET for the query
+	deleteStatement  Delete   // Defines the DELETE clause for specifying the table and optional alias
+	usingStatement   []string // Lists the tables of the USING clause (PostgreSQL)
+	joinStatement    Join     // Represents the JOIN clauses of a multi-table DELETE
+	whereStatement   Where    // Stores conditions for the WHERE clause
+	orderByStatement OrderBy  // Represents sorting conditions for the ORDER BY clause
+	limitStatement   Limit    // Specifies the LIMIT for the query
+	allRows          bool     // Allows the DELETE statement without WHERE clause
+	unscoped         bool     // Disables the scopes of the executed statement, see Unscoped
+	hardDelete       bool     // Removes the rows of a soft deleted table, see HardDelete
 }
 
 // DeleteInstance creates a new instance of DeleteBuilder.
@@ -32,6 +42,31 @@ func DeleteInstance() *DeleteBuilder {
 	return &DeleteBuilder{}
 }
 
+// Clone returns a deep copy of the DeleteBuilder instance, including the joins and conditions.
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the newly created DeleteBuilder instance.
+func (db *DeleteBuilder) Clone() *DeleteBuilder {
+	if db == nil {
+		return nil
+	}
+
+	return &DeleteBuilder{
+		deleteStatement: Delete{
+			Table: cloneValue(db.deleteStatement.Table),
+			Alias: db.deleteStatement.Alias,
+		},
+		usingStatement:   cloneSlice(db.usingStatement),
+		joinStatement:    db.joinStatement.clone(),
+		whereStatement:   db.whereStatement.clone(),
+		orderByStatement: db.orderByStatement.clone(),
+		limitStatement:   db.limitStatement,
+		allRows:          db.allRows,
+		unscoped:         db.unscoped,
+		hardDelete:       db.hardDelete,
+	}
+}
+
 // ====================================================================
 //                   Delete Builder :: Operators
 // ====================================================================
@@ -44,33 +79,92 @@ func DeleteInstance() *DeleteBuilder {
 // Returns:
 //   - A string representing the complete DELETE SQL query.
 func (db *DeleteBuilder) String() string {
-	var queryParts []string
+	return inlineString(db)
+}
 
-	// Add the DELETE statement
-	queryParts = append(queryParts, db.deleteStatement.String())
+// DeleteClause returns the DELETE clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//   - *Delete: The clause, modifying it modifies the builder.
+func (db *DeleteBuilder) DeleteClause() *Delete {
+	return &db.deleteStatement
+}
 
-	// Add the WHERE clause if present
-	whereSql := db.whereStatement.String()
-	if whereSql != "" {
-		queryParts = append(queryParts, whereSql)
-	}
+// JoinClause returns the JOIN clauses of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//   - *Join: The clause, modifying it modifies the builder.
+func (db *DeleteBuilder) JoinClause() *Join {
+	return &db.joinStatement
+}
 
-	// Add the ORDER BY clause if present
-	orderBySql := db.orderByStatement.String()
-	if orderBySql != "" {
-		queryParts = append(queryParts, orderBySql)
-	}
+// WhereClause returns the WHERE clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//   - *Where: The clause, modifying it modifies the builder.
+func (db *DeleteBuilder) WhereClause() *Where {
+	return &db.whereStatement
+}
+
+// OrderByClause returns the ORDER BY clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//   - *OrderBy: The clause, modifying it modifies the builder.
+func (db *DeleteBuilder) OrderByClause() *OrderBy {
+	return &db.orderByStatement
+}
 
-	// Add the LIMIT clause if present
-	limitSql := db.limitStatement.String()
-	if limitSql != "" {
-		queryParts = append(queryParts, limitSql)
+// LimitClause returns the LIMIT clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//   - *Limit: The clause, modifying it modifies the builder.
+func (db *DeleteBuilder) LimitClause() *Limit {
+	return &db.limitStatement
+}
+
+// UsingTables returns the tables of the USING clause.
+//
+// Returns:
+//   - []string: A copy of the USING tables.
+func (db *DeleteBuilder) UsingTables() []string {
+	return append([]string(nil), db.usingStatement...)
+}
+
+// isMultiTable reports whether the DELETE statement references other tables through USING or JOIN.
+//
+// Returns:
+//   - bool: true if USING tables or JOIN clauses are defined.
+func (db *DeleteBuilder) isMultiTable() bool {
+	return len(db.usingStatement) > 0 || len(db.joinStatement.Items) > 0
+}
+
+// usingList converts the USING tables to FROM list entries.
+//
+// Returns:
+//   - []fromItem: One entry per USING table.
+func (db *DeleteBuilder) usingList() []fromItem {
+	var items []fromItem
+
+	for _, table := range db.usingStatement {
+		items = append(items, fromItem{From: From{Table: table}})
 	}
 
-	// Combine all parts into a single SQL string
-	sql := strings.Join(queryParts, " ")
+	return items
+}
+
+// mysqlFromList lists the target table followed by the USING tables for MySQL's multi-table DELETE.
+//
+// Returns:
+//   - []fromItem: The table references of the DELETE ... FROM clause.
+func (db *DeleteBuilder) mysqlFromList() []fromItem {
+	items := []fromItem{{
+		From: From{
+			Table: db.deleteStatement.Table,
+			Alias: db.deleteStatement.Alias,
+		},
+	}}
 
-	return sql
+	return append(items, db.usingList()...)
 }
 
 // Delete specifies the table and an optional alias for the DELETE query.
@@ -91,6 +185,80 @@ func (db *DeleteBuilder) Delete(table string, alias ...string) *DeleteBuilder {
 	return db
 }
 
+// Using adds tables to the USING clause of the DELETE query.
+// PostgreSQL renders DELETE FROM t USING t1, t2, MySQL renders DELETE t FROM t, t1, t2
+// and SQLite renders DELETE FROM t WHERE EXISTS (SELECT 1 FROM t1, t2 WHERE ...).
+//
+// Parameters:
+//   - tables (...string): The tables, with optional aliases, to reference in the WHERE clause.
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) Using(tables ...string) *DeleteBuilder {
+	db.usingStatement = append(db.usingStatement, tables...)
+
+	return db
+}
+
+// Join adds a join clause to the DELETE query.
+// MySQL renders DELETE t FROM t JOIN ... ON ..., PostgreSQL and SQLite convert the joined tables
+// to their USING form where inner join conditions are moved to the WHERE clause.
+//
+// Parameters:
+//   - join (JoinType): The type of join (e.g., INNER JOIN, LEFT JOIN).
+//   - table (string): The table to join.
+//   - condition (Condition): The ON condition for the join. Use Condition.Group for multiple conditions.
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) Join(join JoinType, table string, condition Condition) *DeleteBuilder {
+	db.joinStatement.Append(JoinItem{
+		Join:      join,
+		Table:     table,
+		Condition: condition,
+	})
+
+	return db
+}
+
+// OrderBy adds a field to the ORDER BY clause of the DELETE query (MySQL).
+//
+// Parameters:
+//   - field (string): The field to sort by.
+//   - dir (OrderByDir): The direction of sorting (ASC or DESC).
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) OrderBy(field string, dir OrderByDir) *DeleteBuilder {
+	db.orderByStatement.Append(field, dir)
+
+	return db
+}
+
+// Limit sets the maximum number of rows to delete (MySQL).
+//
+// Parameters:
+//   - limit (int): The maximum number of rows to delete.
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) Limit(limit int) *DeleteBuilder {
+	db.limitStatement.Limit = limit
+
+	return db
+}
+
+// AllRows allows the DELETE query without WHERE clause or without the LIMIT clause required by the
+// SafetyPolicy. Sql returns ErrMissingWhere or ErrMissingLimit otherwise.
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) AllRows() *DeleteBuilder {
+	db.allRows = true
+
+	return db
+}
+
 // Where adds a condition to the WHERE clause using the AND operator.
 //
 // Parameters:
@@ -1,11 +1,10 @@
 package fluentsql
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 // Sql generates the DELETE SQL query string and returns it along with its arguments.
+// An error is returned when the WHERE clause is empty, or when the SafetyPolicy requires a LIMIT clause
+// for the table, unless AllRows was called.
 //
 // Returns:
 //   - string: The DELETE SQL query string.
@@ -27,35 +26,118 @@ func (db *DeleteBuilder) Sql() (string, []any, error) {
 //   - []any: A slice of any type containing the arguments used in the query.
 //   - error: Any error that may occur during the query construction.
 func (db *DeleteBuilder) StringArgs(args []any) (string, []any, error) {
-	var queryParts []string // A slice to gather all query parts (e.g., DELETE, WHERE, etc.).
-	var sqlStr string       // Holds the current query string component.
+	if err := db.check(); err != nil {
+		return "", nil, err
+	}
+
+	r := newRenderer(args)
+	db.render(r)
+
+	return r.finishStatement()
+}
+
+// check returns ErrOuterJoin or ErrMultiTableLimit, or ErrMissingWhere or ErrMissingLimit unless
+// AllRows was called.
+//
+// Returns:
+//   - error: The first failed guard, nil if the statement can be generated.
+func (db *DeleteBuilder) check() error {
+	// MySQL joins the target table, the other dialects attach the outer joins to the USING list.
+	if !IsDialect(MySQL) {
+		if _, _, err := db.joinStatement.asFromList(db.usingList()); err != nil {
+			return err
+		}
+	} else if db.isMultiTable() && (len(db.orderByStatement.Items) > 0 || db.limitStatement.Limit > 0 || db.limitStatement.Offset > 0) {
+		// The multi-table DELETE of MySQL has no ORDER BY and LIMIT clauses
+		return fmt.Errorf("%w: DELETE %v", ErrMultiTableLimit, db.deleteStatement.Table)
+	}
+
+	// Guard against deleting all rows by mistake.
+	if db.allRows {
+		return nil
+	}
+
+	if err := checkWhere("DELETE", db.deleteStatement.Table, db.whereStatement, db.joinStatement); err != nil {
+		return err
+	}
+
+	return checkDeleteLimit(db.deleteStatement.Table, db.limitStatement)
+}
+
+// render writes the DELETE statement without checking the guards, e.g. for String().
+//
+// Parameters:
+//   - r (*renderer): The render context.
+func (db *DeleteBuilder) render(r *renderer) {
+	var whereStatement Where // The WHERE clause, including the conditions of converted joins.
 
-	// Add the DELETE statement and arguments.
-	sqlStr, args = db.deleteStatement.StringArgs(args)
-	queryParts = append(queryParts, sqlStr)
+	update, softDeleted := db.softDeleted()
+
+	switch {
+	case softDeleted:
+		// A soft deleted table sets the deletion timestamp instead, the UPDATE statement has the WHERE clause
+		update.render(r)
+	case !db.isMultiTable():
+		// Add the DELETE statement and arguments.
+		db.deleteStatement.render(r)
+
+		whereStatement = db.whereStatement
+	case IsDialect(MySQL):
+		// DELETE t FROM t JOIN ... for MySQL
+		r.write("DELETE ")
+		r.write(db.deleteStatement.target())
+		r.write(" FROM ")
+		renderFromList(r, db.mysqlFromList())
+
+		if len(db.joinStatement.Items) > 0 {
+			r.writeByte(' ')
+			db.joinStatement.render(r)
+		}
+
+		whereStatement = db.whereStatement
+	default:
+		items, conditions, _ := db.joinStatement.asFromList(db.usingList())
+		whereStatement = Where{Conditions: append(conditions, grouped(db.whereStatement.Conditions)...)}
+
+		db.deleteStatement.render(r)
+
+		if IsDialect(SQLite) {
+			// SQLite has no USING clause: DELETE FROM t WHERE EXISTS (SELECT 1 FROM ... WHERE ...)
+			r.write(" WHERE EXISTS (SELECT 1 FROM ")
+			renderFromList(r, items)
+
+			if len(whereStatement.Conditions) > 0 {
+				r.writeByte(' ')
+				whereStatement.render(r)
+			}
+
+			r.writeByte(')')
+
+			whereStatement = Where{}
+		} else {
+			// DELETE FROM t USING ... for PostgreSQL
+			r.write(" USING ")
+			renderFromList(r, items)
+		}
+	}
 
 	// Add the WHERE clause if present.
-	sqlStr, args = db.whereStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if len(whereStatement.Conditions) > 0 {
+		r.writeByte(' ')
+		whereStatement.render(r)
 	}
 
 	// Add the ORDER BY clause if present.
-	sqlStr, args = db.orderByStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if len(db.orderByStatement.Items) > 0 {
+		r.writeByte(' ')
+		db.orderByStatement.render(r)
 	}
 
 	// Add the LIMIT clause if present.
-	sqlStr, args = db.limitStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if db.limitStatement.Limit > 0 {
+		r.writeByte(' ')
+		db.limitStatement.renderRowCount(r)
 	}
-
-	// Combine all parts into a single SQL string.
-	sql := strings.Join(queryParts, " ")
-
-	return sql, args, nil
 }
 
 // StringArgs generates the DELETE SQL statement as a string and updates the provided arguments.
@@ -67,13 +149,20 @@ func (db *DeleteBuilder) StringArgs(args []any) (string, []any, error) {
 //   - string: The DELETE SQL statement including the table and alias (if present).
 //   - []any: The updated slice of query arguments.
 func (u *Delete) StringArgs(args []any) (string, []any) {
-	var sb strings.Builder                                 // A strings.Builder to construct the query string efficiently.
-	sb.WriteString(fmt.Sprintf("DELETE FROM %s", u.Table)) // Add the table to the DELETE statement.
+	return bindStringArgs(u, args)
+}
+
+// render writes the DELETE FROM clause with the table and its alias.
+//
+// Parameters:
+//   - r (*renderer): The render context.
+func (u *Delete) render(r *renderer) {
+	r.write("DELETE FROM ")
+	r.writeAny(u.Table)
 
 	// Add alias to the DELETE statement if it's not empty.
 	if u.Alias != "" {
-		sb.WriteString(" " + u.Alias)
+		r.writeByte(' ')
+		r.write(u.Alias)
 	}
-
-	return sb.String(), args
 }
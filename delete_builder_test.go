@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -42,3 +43,160 @@ func TestDeleteTableArgs(t *testing.T) {
 		}
 	}
 }
+
+// TestDeleteUsingJoin
+func TestDeleteUsingJoin(t *testing.T) {
+	testCases := map[string]*DeleteBuilder{
+		"DELETE FROM orders o USING archived_orders a WHERE a.order_id = o.id": DeleteInstance().
+			Delete("orders", "o").
+			Using("archived_orders a").
+			Where("a.order_id", Eq, ValueField("o.id")),
+		"DELETE FROM orders o USING archived_orders a, customers c WHERE a.order_id = o.id AND (c.id = o.customer_id AND c.status = 'closed')": DeleteInstance().
+			Delete("orders", "o").
+			Join(InnerJoin, "archived_orders a", Condition{
+				Field: "a.order_id",
+				Opt:   Eq,
+				Value: ValueField("o.id"),
+			}).
+			Join(InnerJoin, "customers c", Condition{
+				Group: WhereInstance().
+					Where("c.id", Eq, ValueField("o.customer_id")).
+					Where("c.status", Eq, "closed").
+					Conditions(),
+			}),
+		"DELETE FROM orders o USING archived_orders a WHERE a.order_id = o.id AND (a.reason = 'fraud' OR a.reason = 'refund')": DeleteInstance().
+			Delete("orders", "o").
+			Join(InnerJoin, "archived_orders a", Condition{
+				Field: "a.order_id",
+				Opt:   Eq,
+				Value: ValueField("o.id"),
+			}).
+			Where("a.reason", Eq, "fraud").
+			WhereOr("a.reason", Eq, "refund"),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
+
+// TestDeleteJoinMySQL
+func TestDeleteJoinMySQL(t *testing.T) {
+	SetDialect(new(MySQLDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query := DeleteInstance().
+		Delete("orders", "o").
+		Join(InnerJoin, "archived_orders a", Condition{
+			Field: "a.order_id",
+			Opt:   Eq,
+			Value: ValueField("o.id"),
+		}).
+		Where("a.archived_at", Lesser, "2024-01-01")
+
+	expected := "DELETE o FROM orders o INNER JOIN archived_orders a ON a.order_id = o.id WHERE a.archived_at < ?"
+
+	sql, args, _ := query.Sql()
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	expected = "DELETE o FROM orders o INNER JOIN archived_orders a ON a.order_id = o.id WHERE a.archived_at < '2024-01-01'"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	if _, _, err := query.OrderBy("o.id", Asc).Limit(100).Sql(); !errors.Is(err, ErrMultiTableLimit) {
+		t.Fatalf(`Error %v != %v`, err, ErrMultiTableLimit)
+	}
+}
+
+// TestDeleteUsingSQLite
+func TestDeleteUsingSQLite(t *testing.T) {
+	SetDialect(new(SQLiteDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query := DeleteInstance().
+		Delete("orders").
+		Using("archived_orders a").
+		Where("a.order_id", Eq, ValueField("orders.id")).
+		Where("a.archived_at", Lesser, "2024-01-01")
+
+	expected := "DELETE FROM orders WHERE EXISTS (SELECT 1 FROM archived_orders a WHERE a.order_id = orders.id AND a.archived_at < ?)"
+
+	sql, args, _ := query.Sql()
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	if len(args) != 1 {
+		t.Fatalf(`Args %v`, args)
+	}
+
+	// The join conditions restrict all the rows matched with OR
+	query = DeleteInstance().
+		Delete("orders").
+		Join(InnerJoin, "archived_orders a", Condition{
+			Field: "a.order_id",
+			Opt:   Eq,
+			Value: ValueField("orders.id"),
+		}).
+		Where("a.reason", Eq, "fraud").
+		WhereOr("a.reason", Eq, "refund")
+
+	expected = "DELETE FROM orders WHERE EXISTS (SELECT 1 FROM archived_orders a WHERE a.order_id = orders.id AND (a.reason = ? OR a.reason = ?))"
+	if sql, args, _ = query.Sql(); sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+}
+
+// TestDeleteOuterJoin
+func TestDeleteOuterJoin(t *testing.T) {
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query := DeleteInstance().
+		Delete("orders", "o").
+		Join(LeftJoin, "archived_orders a", Condition{
+			Field: "a.order_id",
+			Opt:   Eq,
+			Value: ValueField("o.id"),
+		}).
+		Where("a.order_id", Null, nil)
+
+	// The target table cannot be left joined in DELETE ... USING
+	for _, dialect := range []Dialect{new(PostgreSQLDialect), new(SQLiteDialect)} {
+		SetDialect(dialect)
+
+		if _, _, err := query.Sql(); !errors.Is(err, ErrOuterJoin) {
+			t.Fatalf(`Error %s %v != %v`, dialect.Name(), err, ErrOuterJoin)
+		}
+	}
+
+	SetDialect(new(MySQLDialect))
+
+	expected := "DELETE o FROM orders o LEFT JOIN archived_orders a ON a.order_id = o.id WHERE a.order_id IS NULL"
+	if sql, args, err := query.Sql(); err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+	}
+
+	// An outer join attached to a USING table is kept
+	SetDialect(new(PostgreSQLDialect))
+
+	query = DeleteInstance().
+		Delete("orders", "o").
+		Using("customers c").
+		Join(LeftJoin, "archived_customers a", Condition{
+			Field: "a.customer_id",
+			Opt:   Eq,
+			Value: ValueField("c.id"),
+		}).
+		Where("c.id", Eq, ValueField("o.customer_id")).
+		Where("a.customer_id", Null, nil)
+
+	expected = "DELETE FROM orders o USING customers c LEFT JOIN archived_customers a ON a.customer_id = c.id WHERE c.id = o.customer_id AND a.customer_id IS NULL"
+	if sql, args, err := query.Sql(); err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+	}
+}
@@ -0,0 +1,420 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// ====================================================================
+//                   Executor :: Structure
+// ====================================================================
+
+// Runner executes SQL statements. It is satisfied by *sql.DB, *sql.Tx and *sql.Conn.
+type Runner interface {
+	// ExecContext executes a statement without returning any rows.
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	// QueryContext executes a statement that returns rows.
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	// QueryRowContext executes a statement that is expected to return at most one row.
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// QueryError wraps an error returned by the database with the SQL statement which caused it.
+type QueryError struct {
+	// SQL is the generated statement.
+	SQL string
+	// Err is the error returned by the database driver.
+	Err error
+}
+
+// Error returns the driver error followed by the SQL statement.
+//
+// Returns:
+//   - string: The error message.
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("fluentsql: %v [%s]", e.Err, e.SQL)
+}
+
+// Unwrap returns the driver error, so that errors.Is(err, sql.ErrNoRows) keeps working.
+//
+// Returns:
+//   - error: The wrapped error.
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// Row is the result of QueryRowContext on a builder.
+// Unlike *sql.Row, it also carries the error of the statement generation.
+type Row struct {
+	row *sql.Row // row is the row returned by the Runner.
+	sql string   // sql is the generated statement.
+	err error    // err is the error of the statement generation or of the query, as returned by the hooks.
+}
+
+// Scan copies the columns of the row into the values pointed at by dest.
+// It returns sql.ErrNoRows, wrapped in a QueryError, if the query selected no rows.
+//
+// Parameters:
+//   - dest (...any): Pointers to the destination values.
+//
+// Returns:
+//   - error: The generation or driver error, if any.
+func (r *Row) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return wrapError(r.sql, r.row.Scan(dest...))
+}
+
+// Err returns the error of the statement generation or of the query, if any.
+//
+// Returns:
+//   - error: The generation or driver error, if any.
+func (r *Row) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return wrapError(r.sql, r.row.Err())
+}
+
+// ====================================================================
+//                   Executor :: Operators
+// ====================================================================
+
+// wrapError wraps a driver error into a QueryError.
+//
+// Parameters:
+//   - sqlStr (string): The generated statement.
+//   - err (error): The driver error.
+//
+// Returns:
+//   - error: nil if err is nil, a *QueryError otherwise.
+func wrapError(sqlStr string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &QueryError{
+		SQL: sqlStr,
+		Err: err,
+	}
+}
+
+// execContext runs a generated statement which returns no rows.
+// A generation error is reported to the hooks like a driver error, without running the statement.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The database, transaction or connection.
+//   - stmt (Statement): The generated statement with its arguments, kind and table.
+//   - err (error): The error of the statement generation.
+//
+// Returns:
+//   - sql.Result: The result of the statement.
+//   - error: The generation error or the wrapped driver error, possibly replaced by an ErrorMapper.
+func execContext(ctx context.Context, runner Runner, stmt Statement, err error) (sql.Result, error) {
+	return runStatement(ctx, runner, stmt, func(ctx context.Context, runner Runner) (sql.Result, sql.Result, error) {
+		if err != nil {
+			return nil, nil, err
+		}
+
+		result, err := runner.ExecContext(ctx, stmt.SQL, stmt.Args...)
+
+		return result, result, wrapError(stmt.SQL, err)
+	})
+}
+
+// queryContext runs a generated statement which returns rows.
+// A generation error is reported to the hooks like a driver error, without running the statement.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The database, transaction or connection.
+//   - stmt (Statement): The generated statement with its arguments, kind and table.
+//   - err (error): The error of the statement generation.
+//
+// Returns:
+//   - *sql.Rows: The rows of the result, to be closed by the caller.
+//   - error: The generation error or the wrapped driver error, possibly replaced by an ErrorMapper.
+func queryContext(ctx context.Context, runner Runner, stmt Statement, err error) (*sql.Rows, error) {
+	return runStatement(ctx, runner, stmt, func(ctx context.Context, runner Runner) (*sql.Rows, sql.Result, error) {
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rows, err := runner.QueryContext(ctx, stmt.SQL, stmt.Args...)
+
+		return rows, nil, wrapError(stmt.SQL, err)
+	})
+}
+
+// queryRowContext runs a generated statement which returns at most one row.
+// A generation error is reported to the hooks like a driver error, without running the statement.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The database, transaction or connection.
+//   - stmt (Statement): The generated statement with its arguments, kind and table.
+//   - err (error): The error of the statement generation.
+//
+// Returns:
+//   - *Row: The row, its Scan method reports the errors.
+func queryRowContext(ctx context.Context, runner Runner, stmt Statement, err error) *Row {
+	// The error of the row is reported to the hooks, it is returned again by Scan.
+	row, err := runStatement(ctx, runner, stmt, func(ctx context.Context, runner Runner) (*sql.Row, sql.Result, error) {
+		if err != nil {
+			return nil, nil, err
+		}
+
+		row := runner.QueryRowContext(ctx, stmt.SQL, stmt.Args...)
+
+		return row, nil, wrapError(stmt.SQL, row.Err())
+	})
+
+	return &Row{
+		row: row,
+		sql: stmt.SQL,
+		err: err,
+	}
+}
+
+// ExecContext builds the SELECT statement and executes it without returning rows.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - sql.Result: The result of the statement.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (qb *QueryBuilder) ExecContext(ctx context.Context, runner Runner) (sql.Result, error) {
+	sqlStr, args, err := qb.scopedSql(ctx, runner)
+
+	return execContext(ctx, runner, qb.statement(sqlStr, args), err)
+}
+
+// QueryContext builds the SELECT statement and executes it.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *sql.Rows: The rows of the result, to be closed by the caller.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (qb *QueryBuilder) QueryContext(ctx context.Context, runner Runner) (*sql.Rows, error) {
+	sqlStr, args, err := qb.scopedSql(ctx, runner)
+
+	return queryContext(ctx, runner, qb.statement(sqlStr, args), err)
+}
+
+// QueryRowContext builds the SELECT statement and executes it, expecting at most one row.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *Row: The row, its Scan method reports the errors.
+func (qb *QueryBuilder) QueryRowContext(ctx context.Context, runner Runner) *Row {
+	sqlStr, args, err := qb.scopedSql(ctx, runner)
+
+	return queryRowContext(ctx, runner, qb.statement(sqlStr, args), err)
+}
+
+// ExecContext builds the INSERT statement and executes it.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - sql.Result: The result of the statement.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (ib *InsertBuilder) ExecContext(ctx context.Context, runner Runner) (sql.Result, error) {
+	sqlStr, args, err := ib.scopedSql(ctx, runner)
+
+	return execContext(ctx, runner, ib.statement(sqlStr, args), err)
+}
+
+// QueryContext builds the INSERT statement and executes it, e.g. with a RETURNING clause.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *sql.Rows: The rows of the result, to be closed by the caller.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (ib *InsertBuilder) QueryContext(ctx context.Context, runner Runner) (*sql.Rows, error) {
+	sqlStr, args, err := ib.scopedSql(ctx, runner)
+
+	return queryContext(ctx, runner, ib.statement(sqlStr, args), err)
+}
+
+// QueryRowContext builds the INSERT statement and executes it, expecting at most one row.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *Row: The row, its Scan method reports the errors.
+func (ib *InsertBuilder) QueryRowContext(ctx context.Context, runner Runner) *Row {
+	sqlStr, args, err := ib.scopedSql(ctx, runner)
+
+	return queryRowContext(ctx, runner, ib.statement(sqlStr, args), err)
+}
+
+// ExecContext builds the UPDATE statement and executes it.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - sql.Result: The result of the statement.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (ub *UpdateBuilder) ExecContext(ctx context.Context, runner Runner) (sql.Result, error) {
+	sqlStr, args, err := ub.scopedSql(ctx, runner)
+
+	return execContext(ctx, runner, ub.statement(sqlStr, args), err)
+}
+
+// QueryContext builds the UPDATE statement and executes it, e.g. with a RETURNING clause.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *sql.Rows: The rows of the result, to be closed by the caller.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (ub *UpdateBuilder) QueryContext(ctx context.Context, runner Runner) (*sql.Rows, error) {
+	sqlStr, args, err := ub.scopedSql(ctx, runner)
+
+	return queryContext(ctx, runner, ub.statement(sqlStr, args), err)
+}
+
+// QueryRowContext builds the UPDATE statement and executes it, expecting at most one row.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *Row: The row, its Scan method reports the errors.
+func (ub *UpdateBuilder) QueryRowContext(ctx context.Context, runner Runner) *Row {
+	sqlStr, args, err := ub.scopedSql(ctx, runner)
+
+	return queryRowContext(ctx, runner, ub.statement(sqlStr, args), err)
+}
+
+// ExecContext builds the DELETE statement and executes it.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - sql.Result: The result of the statement.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (db *DeleteBuilder) ExecContext(ctx context.Context, runner Runner) (sql.Result, error) {
+	sqlStr, args, err := db.scopedSql(ctx, runner)
+
+	return execContext(ctx, runner, db.statement(sqlStr, args), err)
+}
+
+// QueryContext builds the DELETE statement and executes it, e.g. with a RETURNING clause.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *sql.Rows: The rows of the result, to be closed by the caller.
+//   - error: The generation error or the driver error wrapped in a QueryError.
+func (db *DeleteBuilder) QueryContext(ctx context.Context, runner Runner) (*sql.Rows, error) {
+	sqlStr, args, err := db.scopedSql(ctx, runner)
+
+	return queryContext(ctx, runner, db.statement(sqlStr, args), err)
+}
+
+// QueryRowContext builds the DELETE statement and executes it, expecting at most one row.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or an Executor, its scopes are applied.
+//
+// Returns:
+//   - *Row: The row, its Scan method reports the errors.
+func (db *DeleteBuilder) QueryRowContext(ctx context.Context, runner Runner) *Row {
+	sqlStr, args, err := db.scopedSql(ctx, runner)
+
+	return queryRowContext(ctx, runner, db.statement(sqlStr, args), err)
+}
+
+// statement describes the generated SELECT statement for the hooks.
+//
+// Parameters:
+//   - sqlStr (string): The generated statement.
+//   - args ([]any): The arguments of the statement.
+//
+// Returns:
+//   - Statement: The statement of kind KindSelect.
+func (qb *QueryBuilder) statement(sqlStr string, args []any) Statement {
+	table, _ := qb.fromStatement.Table.(string)
+
+	return Statement{SQL: sqlStr, Args: args, Kind: KindSelect, Table: table, Unscoped: qb.unscoped}
+}
+
+// statement describes the generated INSERT statement for the hooks.
+//
+// Parameters:
+//   - sqlStr (string): The generated statement.
+//   - args ([]any): The arguments of the statement.
+//
+// Returns:
+//   - Statement: The statement of kind KindInsert.
+func (ib *InsertBuilder) statement(sqlStr string, args []any) Statement {
+	return Statement{SQL: sqlStr, Args: args, Kind: KindInsert, Table: ib.insertStatement.Table, Unscoped: ib.unscoped}
+}
+
+// statement describes the generated UPDATE statement for the hooks.
+//
+// Parameters:
+//   - sqlStr (string): The generated statement.
+//   - args ([]any): The arguments of the statement.
+//
+// Returns:
+//   - Statement: The statement of kind KindUpdate.
+func (ub *UpdateBuilder) statement(sqlStr string, args []any) Statement {
+	table, _ := ub.updateStatement.Table.(string)
+
+	return Statement{SQL: sqlStr, Args: args, Kind: KindUpdate, Table: table, Unscoped: ub.unscoped}
+}
+
+// statement describes the generated DELETE statement for the hooks.
+//
+// Parameters:
+//   - sqlStr (string): The generated statement.
+//   - args ([]any): The arguments of the statement.
+//
+// Returns:
+//   - Statement: The statement of kind KindDelete.
+func (db *DeleteBuilder) statement(sqlStr string, args []any) Statement {
+	table, _ := db.deleteStatement.Table.(string)
+
+	return Statement{SQL: sqlStr, Args: args, Kind: KindDelete, Table: table, Unscoped: db.unscoped}
+}
+
+// Compile-time checks that the database/sql types satisfy Runner.
+var (
+	_ Runner = (*sql.DB)(nil)
+	_ Runner = (*sql.Tx)(nil)
+	_ Runner = (*sql.Conn)(nil)
+	_ Runner = (*Executor)(nil)
+)
@@ -0,0 +1,132 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+// TestExecutorExec
+func TestExecutorExec(t *testing.T) {
+	db, fake := newFakeDB(t, func(_ string, _ []any) fakeResult {
+		return fakeResult{RowsAffected: 2}
+	})
+
+	ctx := context.Background()
+
+	testCases := map[string]interface {
+		ExecContext(ctx context.Context, runner Runner) (sql.Result, error)
+	}{
+		"INSERT INTO products (name, price) VALUES ($1, $2)": InsertInstance().
+			Insert("products", "name", "price").
+			Row("Pen", 1.5),
+		"UPDATE products SET price = $1 WHERE id = $2": UpdateInstance().
+			Update("products").
+			Set("price", 2).
+			Where("id", Eq, 7),
+		"DELETE FROM products WHERE id = $1": DeleteInstance().
+			Delete("products").
+			Where("id", Eq, 7),
+	}
+
+	for expected, query := range testCases {
+		result, err := query.ExecContext(ctx, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if affected, _ := result.RowsAffected(); affected != 2 {
+			t.Fatalf(`RowsAffected %d != 2`, affected)
+		}
+
+		if fake.Last().SQL != expected {
+			t.Fatalf(`Query %s != %s`, fake.Last().SQL, expected)
+		}
+	}
+}
+
+// TestExecutorQuery
+func TestExecutorQuery(t *testing.T) {
+	db, fake := newFakeDB(t, func(_ string, _ []any) fakeResult {
+		return fakeResult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]any{{int64(1), "Pen"}, {int64(2), "Ink"}},
+		}
+	})
+
+	ctx := context.Background()
+	query := QueryInstance().
+		Select("id", "name").
+		From("products").
+		Where("price", Greater, 1)
+
+	rows, err := query.QueryContext(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+
+	for rows.Next() {
+		var id int
+		var name string
+
+		if err = rows.Scan(&id, &name); err != nil {
+			t.Fatal(err)
+		}
+
+		names = append(names, name)
+	}
+
+	if err = rows.Close(); err != nil || len(names) != 2 {
+		t.Fatalf(`Names %v (%v)`, names, err)
+	}
+
+	var name string
+	if err = query.QueryRowContext(ctx, db).Scan(new(int), &name); err != nil || name != "Pen" {
+		t.Fatalf(`Name %s (%v)`, name, err)
+	}
+
+	last := fake.Last()
+	if last.SQL != "SELECT id, name FROM products WHERE price > $1" || len(last.Args) != 1 || last.Args[0] != int64(1) {
+		t.Fatalf(`Query %v`, last)
+	}
+}
+
+// TestExecutorErrors
+func TestExecutorErrors(t *testing.T) {
+	errDriver := errors.New("relation does not exist")
+
+	db, _ := newFakeDB(t, func(query string, _ []any) fakeResult {
+		if query == "SELECT id FROM missing" {
+			return fakeResult{Err: errDriver}
+		}
+
+		return fakeResult{Columns: []string{"id"}}
+	})
+
+	ctx := context.Background()
+
+	_, err := QueryInstance().Select("id").From("missing").QueryContext(ctx, db)
+
+	var queryError *QueryError
+	if !errors.As(err, &queryError) || !errors.Is(err, errDriver) || queryError.SQL != "SELECT id FROM missing" {
+		t.Fatalf(`Error %v`, err)
+	}
+
+	err = QueryInstance().Select("id").From("products").QueryRowContext(ctx, db).Scan(new(int))
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf(`Error %v != %v`, err, sql.ErrNoRows)
+	}
+
+	_, err = DeleteInstance().Delete("products").ExecContext(ctx, db)
+	if !errors.Is(err, ErrMissingWhere) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingWhere)
+	}
+
+	err = UpdateInstance().Update("products").QueryRowContext(ctx, db).Scan(new(int))
+	if !errors.Is(err, ErrEmptySet) {
+		t.Fatalf(`Error %v != %v`, err, ErrEmptySet)
+	}
+}
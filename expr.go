@@ -0,0 +1,103 @@
+package fluentsql
+
+import (
+	"strings"
+)
+
+// Expression represents a raw SQL fragment with bound arguments.
+// Each question mark in SQL marks the position of an argument, it is replaced by the placeholder
+// of the current dialect when the statement is built with arguments. The question marks of the
+// quoted strings and identifiers are not arguments.
+//
+// Examples:
+//   - Expr("price * ?", 3) to keep SQL string as `price * 3` or `price * $1`
+//   - Expr("COALESCE(stock, 0) + ?", 1)
+type Expression struct {
+	// SQL is the SQL fragment with question marks for the arguments.
+	SQL string
+	// Args are the values of the question marks, in order.
+	Args []any
+}
+
+// Expr creates a new Expression.
+//
+// Parameters:
+//   - sql: The SQL fragment with question marks for the arguments.
+//   - args: The values of the question marks, in order.
+//
+// Returns:
+//   - Expression: The SQL expression.
+func Expr(sql string, args ...any) Expression {
+	return Expression{
+		SQL:  sql,
+		Args: args,
+	}
+}
+
+// String generates the SQL representation of the expression with the arguments inlined.
+//
+// Returns:
+//   - string: The SQL fragment where each question mark is replaced by its argument value.
+func (e Expression) String() string {
+	return inlineString(e)
+}
+
+// StringArgs generates the SQL representation of the expression with placeholders
+// and appends the arguments to the slice.
+//
+// Parameters:
+//   - args: The input slice to which the expression arguments will be appended.
+//
+// Returns:
+//   - string: The SQL fragment where each question mark is replaced by a placeholder.
+//   - []any: The updated slice of arguments.
+func (e Expression) StringArgs(args []any) (string, []any) {
+	return bindStringArgs(e, args)
+}
+
+// render writes the expression, each question mark is replaced by the value of its argument.
+// The question marks without an argument, and those of the quoted strings and identifiers, are kept.
+//
+// Parameters:
+//   - r: The render context.
+func (e Expression) render(r *renderer) {
+	sqlStr := e.SQL
+
+	for i := 0; ; {
+		pos := strings.IndexAny(sqlStr, "?'\"`")
+		if pos < 0 {
+			r.write(sqlStr)
+
+			return
+		}
+
+		r.write(sqlStr[:pos])
+
+		if c := sqlStr[pos]; c != '?' {
+			// Copy the quoted string or identifier
+			end := strings.IndexByte(sqlStr[pos+1:], c)
+			if end < 0 {
+				r.write(sqlStr[pos:])
+
+				return
+			}
+
+			r.write(sqlStr[pos : pos+end+2])
+			sqlStr = sqlStr[pos+end+2:]
+
+			continue
+		}
+
+		if i < len(e.Args) {
+			r.value(e.Args[i])
+		} else {
+			r.write(question)
+		}
+
+		i++
+		sqlStr = sqlStr[pos+len(question):]
+	}
+}
+
+// ValueDefault represents the DEFAULT keyword as the value of an assignment or an inserted column.
+const ValueDefault = ValueField("DEFAULT")
@@ -0,0 +1,59 @@
+package fluentsql
+
+import "testing"
+
+// TestExpression
+func TestExpression(t *testing.T) {
+	testCases := map[string]Expression{
+		"price * 3":                  Expr("price * ?", 3),
+		"COALESCE(name, 'unknown')":  Expr("COALESCE(name, ?)", "unknown"),
+		"price BETWEEN 1 AND NULL":   Expr("price BETWEEN ? AND ?", 1, nil),
+		"CURRENT_TIMESTAMP":          Expr("CURRENT_TIMESTAMP"),
+		"quantity * price + ?":       Expr("quantity * price + ?"),
+		"(SELECT MAX(price) FROM p)": Expr("?", QueryInstance().Select("MAX(price)").From("p")),
+	}
+
+	for expected, expression := range testCases {
+		if expression.String() != expected {
+			t.Fatalf(`Expression %s != %s`, expression.String(), expected)
+		}
+	}
+}
+
+// TestExpressionArgs
+func TestExpressionArgs(t *testing.T) {
+	testCases := map[string]Expression{
+		"price * $1":         Expr("price * ?", 3),
+		"COALESCE(name, $1)": Expr("COALESCE(name, ?)", "unknown"),
+		"(SELECT MAX(price) FROM p WHERE a = $1)": Expr("?", QueryInstance().Select("MAX(price)").From("p").Where("a", Eq, 1)),
+	}
+
+	for expected, expression := range testCases {
+		sql, args := expression.StringArgs(nil)
+
+		if sql != expected {
+			t.Fatalf(`Expression %s != %s (%v)`, sql, expected, args)
+		}
+	}
+}
+
+// TestConditionExpression
+func TestConditionExpression(t *testing.T) {
+	query := QueryInstance().
+		Select("id").
+		From("orders").
+		Where("total", Greater, Expr("price * ?", 2)).
+		Where("status", In, []any{"paid", 3})
+
+	expected := "SELECT id FROM orders WHERE total > price * 2 AND status IN ('paid', 3)"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	expected = "SELECT id FROM orders WHERE total > price * $1 AND status IN ($2, $3)"
+
+	sql, args, _ := query.Sql()
+	if sql != expected || len(args) != 3 {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+}
@@ -0,0 +1,229 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"sync"
+	"testing"
+)
+
+// fakeDriverName is the name of the in-process database/sql driver used by the executor tests.
+const fakeDriverName = "fluentsql-fake"
+
+// fakeDatabases maps a DSN to its fake database.
+var fakeDatabases sync.Map
+
+func init() {
+	sql.Register(fakeDriverName, fakeDriver{})
+}
+
+// fakeQuery is a statement received by the fake driver.
+type fakeQuery struct {
+	SQL  string
+	Args []any
+}
+
+// fakeResult is the answer of the fake driver to a statement.
+type fakeResult struct {
+	Columns      []string
+	Rows         [][]any
+	RowsAffected int64
+	Err          error
+}
+
+// fakeHandler answers a statement received by the fake driver.
+type fakeHandler func(query string, args []any) fakeResult
+
+// fakeDB records the statements received by the fake driver.
+type fakeDB struct {
+	mu          sync.Mutex
+	handler     fakeHandler
+	queries     []fakeQuery
+	prepares    int
+	closed      int
+	closedStmts int
+}
+
+// newFakeDB opens a *sql.DB on the fake driver, its statements are answered by handler.
+func newFakeDB(t *testing.T, handler fakeHandler) (*sql.DB, *fakeDB) {
+	t.Helper()
+
+	fake := &fakeDB{handler: handler}
+	fakeDatabases.Store(t.Name(), fake)
+
+	db, err := sql.Open(fakeDriverName, t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+		fakeDatabases.Delete(t.Name())
+	})
+
+	return db, fake
+}
+
+// answer records a statement and returns the answer of the handler.
+func (f *fakeDB) answer(query string, args []driver.Value) fakeResult {
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+
+	f.mu.Lock()
+	f.queries = append(f.queries, fakeQuery{SQL: query, Args: values})
+	f.mu.Unlock()
+
+	if f.handler == nil {
+		return fakeResult{}
+	}
+
+	return f.handler(query, values)
+}
+
+// Queries returns the SQL of the received statements.
+func (f *fakeDB) Queries() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	queries := make([]string, len(f.queries))
+	for i, query := range f.queries {
+		queries[i] = query.SQL
+	}
+
+	return queries
+}
+
+// Last returns the last received statement.
+func (f *fakeDB) Last() fakeQuery {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.queries) == 0 {
+		return fakeQuery{}
+	}
+
+	return f.queries[len(f.queries)-1]
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fake, ok := fakeDatabases.Load(name)
+	if !ok {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return &fakeConn{db: fake.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	c.db.mu.Lock()
+	c.db.prepares++
+	c.db.mu.Unlock()
+
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(_ context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	if result := c.db.answer("BEGIN", nil); result.Err != nil {
+		return nil, result.Err
+	}
+
+	return &fakeTx{conn: c}, nil
+}
+
+type fakeTx struct {
+	conn *fakeConn
+}
+
+func (tx *fakeTx) Commit() error {
+	return tx.conn.db.answer("COMMIT", nil).Err
+}
+
+func (tx *fakeTx) Rollback() error {
+	return tx.conn.db.answer("ROLLBACK", nil).Err
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	s.conn.db.mu.Lock()
+	s.conn.db.closedStmts++
+	s.conn.db.mu.Unlock()
+
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	result := s.conn.db.answer(s.query, args)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	return driver.RowsAffected(result.RowsAffected), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	result := s.conn.db.answer(s.query, args)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	return &fakeRows{db: s.conn.db, columns: result.Columns, rows: result.Rows}, nil
+}
+
+type fakeRows struct {
+	db      *fakeDB
+	columns []string
+	rows    [][]any
+	next    int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	r.db.mu.Lock()
+	r.db.closed++
+	r.db.mu.Unlock()
+
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+
+	for i, value := range r.rows[r.next] {
+		dest[i] = value
+	}
+
+	r.next++
+
+	return nil
+}
@@ -1,7 +1,5 @@
 package fluentsql
 
-import "fmt"
-
 // Fetch clause represents a SQL FETCH clause with offset and limit.
 type Fetch struct {
 	// Fetch specifies the number of rows to fetch.
@@ -18,8 +16,5 @@ type Fetch struct {
 // Returns:
 //   - A string representing the SQL FETCH clause.
 func (f *Fetch) String() string {
-	if f.Fetch > 0 || f.Offset > 0 {
-		return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", f.Offset, f.Fetch)
-	}
-	return ""
+	return inlineString(f)
 }
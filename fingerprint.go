@@ -0,0 +1,83 @@
+package fluentsql
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Shape identifies the structure of a statement, independently of its argument values and of the
+// length of its IN lists, e.g. to group the metrics of slow queries.
+type Shape struct {
+	// ID is the hash of the normalized SQL template, 16 hexadecimal digits.
+	ID string
+	// SQL is the normalized SQL template, e.g. SELECT id FROM users WHERE id IN (...) LIMIT ? OFFSET ?
+	SQL string
+}
+
+// Fingerprint returns the shape of the SELECT statement.
+//
+// Returns:
+// - Shape: The ID and the normalized SQL template.
+func (qb *QueryBuilder) Fingerprint() Shape {
+	return newShape(qb.renderStatement)
+}
+
+// Fingerprint returns the shape of the INSERT statement. The VALUES rows are collapsed to (...).
+//
+// Returns:
+//
+//	Shape - The ID and the normalized SQL template.
+func (ib *InsertBuilder) Fingerprint() Shape {
+	return newShape(ib.render)
+}
+
+// Fingerprint returns the shape of the UPDATE statement, the WHERE guard is not applied.
+//
+// Returns:
+// - Shape: The ID and the normalized SQL template.
+func (ub *UpdateBuilder) Fingerprint() Shape {
+	return newShape(ub.render)
+}
+
+// Fingerprint returns the shape of the DELETE statement, the WHERE and LIMIT guards are not applied.
+//
+// Returns:
+//   - Shape: The ID and the normalized SQL template.
+func (db *DeleteBuilder) Fingerprint() Shape {
+	return newShape(db.render)
+}
+
+// newShape renders a statement as a template and hashes it. The values are written as ?, whatever
+// the placeholders of the dialect, and the IN lists and VALUES rows are collapsed to (...). The raw
+// SQL of the expressions and the other lists, e.g. COALESCE(?, ?) or (a, b) > (?, ?), are kept.
+//
+// Parameters:
+//   - render: The render method of the statement.
+//
+// Returns:
+//   - Shape: The shape, empty if the statement is empty.
+//
+// Examples:
+//   - SELECT * FROM users WHERE id IN ($1, $2, $3) LIMIT $4 OFFSET $5
+//     has the template SELECT * FROM users WHERE id IN (...) LIMIT ? OFFSET ?
+//   - INSERT INTO users (name, age) VALUES (?, ?), (?, ?)
+//     has the template INSERT INTO users (name, age) VALUES (...)
+func newShape(render func(r *renderer)) Shape {
+	r := newRenderer(nil)
+	r.shape = true
+
+	render(r)
+
+	template, _ := r.finish()
+	if template == "" {
+		return Shape{}
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(template))
+
+	return Shape{
+		ID:  fmt.Sprintf("%016x", hash.Sum64()),
+		SQL: template,
+	}
+}
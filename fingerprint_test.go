@@ -0,0 +1,75 @@
+package fluentsql
+
+import (
+	"testing"
+)
+
+// TestFingerprint
+func TestFingerprint(t *testing.T) {
+	testCases := map[string]interface{ Fingerprint() Shape }{
+		"SELECT id, name FROM users WHERE status = ? AND id IN (...) ORDER BY id ASC LIMIT ? OFFSET ?": QueryInstance().
+			Select("id", "name").
+			From("users").
+			Where("status", Eq, "active").
+			Where("id", In, []int{1, 2, 3}).
+			OrderBy("id", Asc).
+			Limit(10, 20),
+		"INSERT INTO users (name, age) VALUES (...)": InsertInstance().
+			Insert("users", "name", "age").
+			Row("John", 30).
+			Row("Jane", 25),
+		"UPDATE users SET name = ?, visits = visits + 1": UpdateInstance().
+			Update("users").
+			Set("name", "John").
+			Set("visits", Expr("visits + 1")),
+		"DELETE FROM users WHERE created_at < ?": DeleteInstance().
+			Delete("users").
+			Where("created_at", Lesser, "2024-01-01"),
+		"SELECT id FROM users WHERE code = CONCAT('$1', ?) AND nick = COALESCE(?, ?) AND (created_at, id) < (?, ?) ORDER BY created_at DESC, id DESC": QueryInstance().
+			Select("id").
+			From("users").
+			Where("code", Eq, Expr("CONCAT('$1', ?)", "x")).
+			Where("nick", Eq, Expr("COALESCE(?, ?)", "a", "b")).
+			OrderBy("created_at", Desc).
+			OrderBy("id", Desc).
+			SeekAfterValues("2024-01-02", 42),
+		"UPDATE summary SET (sum_x, sum_y) = (?, ?) WHERE id IN (...)": UpdateInstance().
+			Update("summary").
+			Set([]string{"sum_x", "sum_y"}, []any{1, 2}).
+			Where("id", In, []any{1, 2, 3}),
+	}
+
+	for expected, builder := range testCases {
+		if shape := builder.Fingerprint(); shape.SQL != expected || len(shape.ID) != 16 {
+			t.Fatalf(`Query %s != %s (%s)`, shape.SQL, expected, shape.ID)
+		}
+	}
+}
+
+// TestFingerprintStable
+func TestFingerprintStable(t *testing.T) {
+	query := func(status string, ids ...int) *QueryBuilder {
+		return QueryInstance().
+			Select("id").
+			From("users").
+			Where("status", Eq, status).
+			Where("id", In, ids)
+	}
+
+	shape := query("active", 1).Fingerprint()
+
+	if other := query("inactive", 4, 5, 6, 7).Fingerprint(); other != shape {
+		t.Fatalf(`Shape %v != %v`, other, shape)
+	}
+
+	SetDialect(new(MySQLDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	if other := query("blocked", 8, 9).Fingerprint(); other != shape {
+		t.Fatalf(`Shape %v != %v`, other, shape)
+	}
+
+	if other := query("active", 1).Where("age", Greater, 18).Fingerprint(); other.ID == shape.ID {
+		t.Fatalf(`Shapes of different queries must differ: %v`, other)
+	}
+}
@@ -1,6 +1,10 @@
 package fluentsql
 
-import "fmt"
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
 
 // ====================================================================
 // =========================== Interfaces =============================
@@ -21,6 +25,29 @@ type Dialect interface {
 	YearFunction(field string) string
 }
 
+// IdentifierQuoter is implemented by the dialects quoting identifiers, such as column aliases,
+// otherwise than with the double quotes of the SQL standard.
+type IdentifierQuoter interface {
+	// QuoteIdentifier quotes an identifier such as a column alias.
+	// For example, MySQL uses `user.id`, PostgreSQL and SQLite use "user.id"
+	QuoteIdentifier(name string) string
+}
+
+// RowValuesDialect is implemented by the dialects supporting row value comparisons such as (a, b) > (1, 2).
+// Keyset pagination falls back to an OR-chain of comparisons for the other dialects.
+type RowValuesDialect interface {
+	// RowValues reports whether row value comparisons are supported.
+	RowValues() bool
+}
+
+// NowDialect is implemented by the dialects whose current timestamp expression is not the
+// CURRENT_TIMESTAMP of the SQL standard, e.g. for soft deletes.
+type NowDialect interface {
+	// Now returns the SQL expression of the current timestamp.
+	// For example, MySQL and PostgreSQL use NOW(), SQLite uses CURRENT_TIMESTAMP
+	Now() string
+}
+
 // ====================================================================
 // ========================== Declarations ============================
 // ====================================================================
@@ -49,6 +76,53 @@ var (
 	defaultDialect Dialect = new(PostgreSQLDialect)
 )
 
+// ====================================================================
+// ============================= Errors ===============================
+// ====================================================================
+
+var (
+	// ErrEmptySet is returned by UpdateBuilder.Sql when the SET clause has no items.
+	ErrEmptySet = errors.New("fluentsql: UPDATE without SET items")
+
+	// ErrNoChanges is returned by UpdateBuilder.Sql when SetChanged found no changed fields.
+	ErrNoChanges = errors.New("fluentsql: no changed fields to update")
+
+	// ErrMissingWhere is returned by UpdateBuilder.Sql and DeleteBuilder.Sql when the WHERE clause is empty
+	// and AllRows was not called.
+	ErrMissingWhere = errors.New("fluentsql: statement without WHERE clause")
+
+	// ErrMissingLimit is returned by DeleteBuilder.Sql when a table listed in SafetyPolicy.LimitTables
+	// is deleted from without a LIMIT clause, or on a dialect without DELETE ... LIMIT.
+	ErrMissingLimit = errors.New("fluentsql: DELETE without LIMIT clause")
+
+	// ErrNotStruct is returned when a struct or a pointer to a struct is expected.
+	ErrNotStruct = errors.New("fluentsql: value is not a struct")
+
+	// ErrInvalidCursor is returned by QueryBuilder.Sql when the cursor of SeekAfter cannot be decoded
+	// or does not match the ORDER BY items.
+	ErrInvalidCursor = errors.New("fluentsql: invalid cursor")
+
+	// ErrMissingParam is returned by Bind when no value is given for a Param slot, and by the driver
+	// when a statement runs with an unbound Param.
+	ErrMissingParam = errors.New("fluentsql: missing parameter value")
+
+	// ErrParamList is returned by Sql when a Param is the value of an IN or NOT IN condition, the list
+	// of values cannot be bound to one placeholder.
+	ErrParamList = errors.New("fluentsql: IN list bound to a single parameter")
+
+	// ErrScopeViolation is returned when an INSERT or UPDATE statement run with a Scope would write
+	// rows out of the scope, or when the scoped column cannot be added to an INSERT statement.
+	ErrScopeViolation = errors.New("fluentsql: statement violates scope")
+
+	// ErrMultiTableLimit is returned by DeleteBuilder.Sql on MySQL when a DELETE statement with joins or
+	// USING tables has an ORDER BY or LIMIT clause, the multi-table DELETE of MySQL has none.
+	ErrMultiTableLimit = errors.New("fluentsql: ORDER BY or LIMIT in a multi-table DELETE")
+
+	// ErrOuterJoin is returned by UpdateBuilder.Sql and DeleteBuilder.Sql on PostgreSQL and SQLite when
+	// an outer join has no FROM or USING table to be attached to, the target table cannot be joined there.
+	ErrOuterJoin = errors.New("fluentsql: outer join without a preceding FROM or USING table")
+)
+
 // DefaultDialect returns the default dialect.
 // This is for backward compatibility.
 func DefaultDialect() Dialect {
@@ -104,6 +178,30 @@ func (d MySQLDialect) YearFunction(field string) string {
 	return "YEAR(" + field + ")"
 }
 
+// QuoteIdentifier returns the identifier quoted with backticks for MySQL.
+//
+// Parameter:
+//   - name: The identifier to quote
+//
+// Returns a string containing the quoted identifier.
+func (d MySQLDialect) QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// RowValues reports that MySQL supports row value comparisons.
+//
+// Returns true.
+func (d MySQLDialect) RowValues() bool {
+	return true
+}
+
+// Now returns the current timestamp expression of MySQL.
+//
+// Returns a string containing NOW().
+func (d MySQLDialect) Now() string {
+	return "NOW()"
+}
+
 // ====================================================================
 // ======================== PostgreSQLDialect =========================
 // ====================================================================
@@ -123,7 +221,7 @@ func (d PostgreSQLDialect) Name() string {
 //
 // Returns a string containing the dollar-prefixed position (e.g. "$1", "$2", etc).
 func (d PostgreSQLDialect) Placeholder(position int) string {
-	return dollar + fmt.Sprintf("%d", position)
+	return dollar + strconv.Itoa(position)
 }
 
 // YearFunction returns the PostgreSQL-specific function to extract the year from a date.
@@ -136,6 +234,30 @@ func (d PostgreSQLDialect) YearFunction(field string) string {
 	return "DATE_PART('year', " + field + ")"
 }
 
+// QuoteIdentifier returns the identifier quoted with double quotes for PostgreSQL.
+//
+// Parameter:
+//   - name: The identifier to quote
+//
+// Returns a string containing the quoted identifier.
+func (d PostgreSQLDialect) QuoteIdentifier(name string) string {
+	return quoteDouble(name)
+}
+
+// RowValues reports that PostgreSQL supports row value comparisons.
+//
+// Returns true.
+func (d PostgreSQLDialect) RowValues() bool {
+	return true
+}
+
+// Now returns the current timestamp expression of PostgreSQL.
+//
+// Returns a string containing NOW().
+func (d PostgreSQLDialect) Now() string {
+	return "NOW()"
+}
+
 // ====================================================================
 // ========================== SQLiteDialect ===========================
 // ====================================================================
@@ -168,20 +290,74 @@ func (d SQLiteDialect) YearFunction(field string) string {
 	return "strftime('%Y', " + field + ")"
 }
 
+// QuoteIdentifier returns the identifier quoted with double quotes for SQLite.
+//
+// Parameter:
+//   - name: The identifier to quote
+//
+// Returns a string containing the quoted identifier.
+func (d SQLiteDialect) QuoteIdentifier(name string) string {
+	return quoteDouble(name)
+}
+
+// RowValues reports that SQLite supports row value comparisons.
+//
+// Returns true.
+func (d SQLiteDialect) RowValues() bool {
+	return true
+}
+
+// Now returns the current timestamp expression of SQLite.
+//
+// Returns a string containing CURRENT_TIMESTAMP.
+func (d SQLiteDialect) Now() string {
+	return "CURRENT_TIMESTAMP"
+}
+
 // ====================================================================
 // ============================ Utilities =============================
 // ====================================================================
 
-// p generates the correct placeholder format based on the current database dialect.
+// quoteIdentifier quotes an identifier with the dialect, see IdentifierQuoter.
 // Parameters:
-//   - args ([]any): A slice of arguments used to calculate the placeholder number for PostgreSQL.
+//   - name (string): The identifier to quote.
 //
 // Output:
-//   - (string): The placeholder-formatted string.
+//   - (string): The quoted identifier, with double quotes if the dialect is not an IdentifierQuoter.
+func quoteIdentifier(name string) string {
+	if quoter, ok := defaultDialect.(IdentifierQuoter); ok {
+		return quoter.QuoteIdentifier(name)
+	}
+
+	return quoteDouble(name)
+}
+
+// rowValues reports whether the dialect supports row value comparisons, see RowValuesDialect.
+// Output:
+//   - (bool): false if the dialect is not a RowValuesDialect.
+func rowValues() bool {
+	dialect, ok := defaultDialect.(RowValuesDialect)
+
+	return ok && dialect.RowValues()
+}
+
+// now returns the current timestamp expression of the dialect, see NowDialect.
+// Output:
+//   - (string): CURRENT_TIMESTAMP if the dialect is not a NowDialect.
+func now() string {
+	if dialect, ok := defaultDialect.(NowDialect); ok {
+		return dialect.Now()
+	}
+
+	return "CURRENT_TIMESTAMP"
+}
+
+// quoteDouble quotes an identifier with double quotes, as defined by the SQL standard.
+// Parameters:
+//   - name (string): The identifier to quote.
 //
-// Notes:
-//   - MySQL and SQLite use question marks (?) for placeholders.
-//   - PostgreSQL uses dollar-prefixed positional placeholders (e.g., $1, $2).
-func p(args []any) string {
-	return defaultDialect.Placeholder(len(args))
+// Output:
+//   - (string): The quoted identifier, inner double quotes are doubled.
+func quoteDouble(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
 }
@@ -1,3 +1,3 @@
 module github.com/jivegroup/fluentsql
 
-go 1.21.5
+go 1.23.0
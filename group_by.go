@@ -1,10 +1,5 @@
 package fluentsql
 
-import (
-	"fmt"
-	"strings"
-)
-
 // GroupBy clause
 type GroupBy struct {
 	// Items stores the list of fields that will be grouped by in the query.
@@ -24,9 +19,5 @@ func (g *GroupBy) Append(field ...string) {
 // Returns:
 //   - string: The SQL representation of the GroupBy clause. Returns an empty string if no fields are added.
 func (g *GroupBy) String() string {
-	if len(g.Items) == 0 {
-		return ""
-	}
-
-	return fmt.Sprintf("GROUP BY %s", strings.Join(g.Items, ", "))
+	return inlineString(g)
 }
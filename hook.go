@@ -0,0 +1,290 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ====================================================================
+//                   Hook :: Structure
+// ====================================================================
+
+// StatementKind is the kind of the builder which generated a statement.
+type StatementKind string
+
+const (
+	KindSelect StatementKind = "select" // QueryBuilder
+	KindInsert StatementKind = "insert" // InsertBuilder
+	KindUpdate StatementKind = "update" // UpdateBuilder
+	KindDelete StatementKind = "delete" // DeleteBuilder
+	KindRaw    StatementKind = "raw"    // Other raw SQL run on an Executor
+)
+
+// Statement describes an executed statement for the hooks.
+type Statement struct {
+	// SQL is the generated statement.
+	SQL string
+	// Args are the arguments of the statement.
+	Args []any
+	// Kind is the kind of the builder.
+	Kind StatementKind
+	// Table is the main table of the builder, empty for raw statements and subqueries.
+	Table string
+	// Label identifies the caller, see WithLabel.
+	Label string
+	// Unscoped is set when the builder bypassed the scopes with Unscoped, e.g. to be audited.
+	Unscoped bool
+}
+
+// Hook observes the executed statements, e.g. for logging, timing or tracing.
+type Hook interface {
+	// BeforeQuery is called before the statement runs. The returned context is used for the statement
+	// and passed to AfterQuery.
+	BeforeQuery(ctx context.Context, stmt Statement) context.Context
+	// AfterQuery is called after the statement ran. The result is nil for statements returning rows.
+	AfterQuery(ctx context.Context, stmt Statement, result sql.Result, err error, duration time.Duration)
+}
+
+// ErrorMapper is an optional interface of a Hook which replaces the errors of the statements,
+// e.g. to map a unique violation of the driver onto a domain error.
+type ErrorMapper interface {
+	// MapError returns the error returned to the caller.
+	MapError(ctx context.Context, stmt Statement, err error) error
+}
+
+// Executor wraps a Runner with hooks. It is itself a Runner, so builders executed on it and raw
+// statements run through it are observed by its hooks, in addition to the global hooks.
+type Executor struct {
+	runner Runner     // runner is the wrapped database, transaction or connection.
+	hooks  []Hook     // hooks are the hooks of the executor.
+	cache  *stmtCache // cache holds the prepared statements, see WithStmtCache.
+	scopes []*Scope   // scopes are applied to the statements of the builders, see WithScope.
+}
+
+// labelKey is the context key of the caller label.
+type labelKey struct{}
+
+var (
+	// hooksMu guards hooks.
+	hooksMu sync.RWMutex
+	// hooks are the global hooks.
+	hooks []Hook
+)
+
+// ====================================================================
+//                   Hook :: Operators
+// ====================================================================
+
+// AddHook registers a global hook, called for every builder executed on any Runner.
+//
+// Parameters:
+//   - hook (Hook): The hook.
+func AddHook(hook Hook) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+
+	hooks = append(hooks, hook)
+}
+
+// ClearHooks removes the global hooks.
+func ClearHooks() {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+
+	hooks = nil
+}
+
+// WithLabel returns a context carrying a caller label, reported in Statement.Label.
+//
+// Parameters:
+//   - ctx (context.Context): The parent context.
+//   - label (string): The label, e.g. "users.FindByEmail".
+//
+// Returns:
+//   - context.Context: The context with the label.
+func WithLabel(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, labelKey{}, label)
+}
+
+// NewExecutor wraps a Runner with hooks.
+//
+// Parameters:
+//   - runner (Runner): A *sql.DB, *sql.Tx, *sql.Conn or another Executor.
+//   - hooks (...Hook): The hooks of the executor.
+//
+// Returns:
+//   - *Executor: The executor.
+func NewExecutor(runner Runner, hooks ...Hook) *Executor {
+	return &Executor{
+		runner: runner,
+		hooks:  hooks,
+	}
+}
+
+// ExecContext runs a raw statement without returning any rows.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - query (string): The statement.
+//   - args (...any): The arguments of the statement.
+//
+// Returns:
+//   - sql.Result: The result of the statement.
+//   - error: The driver error, possibly replaced by an ErrorMapper.
+func (e *Executor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	stmt := Statement{SQL: query, Args: args, Kind: statementKind(query)}
+
+	return runStatement(ctx, e, stmt, func(ctx context.Context, runner Runner) (sql.Result, sql.Result, error) {
+		result, err := runner.ExecContext(ctx, query, args...)
+
+		return result, result, err
+	})
+}
+
+// QueryContext runs a raw statement that returns rows.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - query (string): The statement.
+//   - args (...any): The arguments of the statement.
+//
+// Returns:
+//   - *sql.Rows: The rows of the result, to be closed by the caller.
+//   - error: The driver error, possibly replaced by an ErrorMapper.
+func (e *Executor) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	stmt := Statement{SQL: query, Args: args, Kind: statementKind(query)}
+
+	return runStatement(ctx, e, stmt, func(ctx context.Context, runner Runner) (*sql.Rows, sql.Result, error) {
+		rows, err := runner.QueryContext(ctx, query, args...)
+
+		return rows, nil, err
+	})
+}
+
+// QueryRowContext runs a raw statement that is expected to return at most one row.
+// The hooks are reported the error of the row, not sql.ErrNoRows which is only known by Scan.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - query (string): The statement.
+//   - args (...any): The arguments of the statement.
+//
+// Returns:
+//   - *sql.Row: The row.
+func (e *Executor) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	stmt := Statement{SQL: query, Args: args, Kind: statementKind(query)}
+
+	row, _ := runStatement(ctx, e, stmt, func(ctx context.Context, runner Runner) (*sql.Row, sql.Result, error) {
+		row := runner.QueryRowContext(ctx, query, args...)
+
+		return row, nil, row.Err()
+	})
+
+	return row
+}
+
+// Runner returns the wrapped Runner, e.g. to start a transaction on the wrapped *sql.DB.
+//
+// Returns:
+//   - Runner: The wrapped database, transaction or connection.
+func (e *Executor) Runner() Runner {
+	return e.runner
+}
+
+// runStatement runs a statement between the hooks. The global hooks are called first, then the hooks of
+// the executors wrapping the runner, from the outermost. AfterQuery is called in reverse order.
+// The statement runs on the statement cache of the outermost executor having one.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The database, transaction, connection or Executor.
+//   - stmt (Statement): The statement, its label is read from ctx if empty.
+//   - run (func): Runs the statement on the unwrapped runner and returns its value, result and error.
+//
+// Returns:
+//   - T: The value of run.
+//   - error: The error of run, possibly replaced by the ErrorMapper hooks.
+func runStatement[T any](ctx context.Context, runner Runner, stmt Statement, run func(ctx context.Context, runner Runner) (T, sql.Result, error)) (T, error) {
+	hooksMu.RLock()
+	stmtHooks := append([]Hook(nil), hooks...)
+	hooksMu.RUnlock()
+
+	var cache *stmtCache
+
+	for {
+		executor, ok := runner.(*Executor)
+		if !ok {
+			break
+		}
+
+		if cache == nil {
+			cache = executor.cache
+		}
+
+		stmtHooks = append(stmtHooks, executor.hooks...)
+		runner = executor.runner
+	}
+
+	if cache != nil {
+		runner = cachedRunnerOf(cache, runner)
+	}
+
+	if len(stmtHooks) == 0 {
+		value, _, err := run(ctx, runner)
+
+		return value, err
+	}
+
+	if stmt.Label == "" {
+		stmt.Label, _ = ctx.Value(labelKey{}).(string)
+	}
+
+	for _, hook := range stmtHooks {
+		ctx = hook.BeforeQuery(ctx, stmt)
+	}
+
+	start := time.Now()
+	value, result, err := run(ctx, runner)
+	duration := time.Since(start)
+
+	for i := len(stmtHooks) - 1; i >= 0; i-- {
+		stmtHooks[i].AfterQuery(ctx, stmt, result, err, duration)
+	}
+
+	if err != nil {
+		for _, hook := range stmtHooks {
+			if mapper, ok := hook.(ErrorMapper); ok {
+				err = mapper.MapError(ctx, stmt, err)
+			}
+		}
+	}
+
+	return value, err
+}
+
+// statementKind infers the kind of a raw statement from its first keyword.
+//
+// Parameters:
+//   - sqlStr (string): The statement.
+//
+// Returns:
+//   - StatementKind: The kind, KindRaw if the keyword is not SELECT, INSERT, UPDATE or DELETE.
+func statementKind(sqlStr string) StatementKind {
+	keyword, _, _ := strings.Cut(strings.TrimSpace(sqlStr), " ")
+
+	switch strings.ToUpper(keyword) {
+	case "SELECT":
+		return KindSelect
+	case "INSERT":
+		return KindInsert
+	case "UPDATE":
+		return KindUpdate
+	case "DELETE":
+		return KindDelete
+	}
+
+	return KindRaw
+}
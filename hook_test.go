@@ -0,0 +1,175 @@
+package fluentsql
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordHook records the statements of the hooks.
+type recordHook struct {
+	name    string
+	events  *[]string
+	stmts   []Statement
+	errs    []error
+	mapping error
+}
+
+func (h *recordHook) BeforeQuery(ctx context.Context, stmt Statement) context.Context {
+	*h.events = append(*h.events, "before "+h.name)
+
+	return ctx
+}
+
+func (h *recordHook) AfterQuery(_ context.Context, stmt Statement, _ sql.Result, err error, _ time.Duration) {
+	*h.events = append(*h.events, "after "+h.name)
+	h.stmts = append(h.stmts, stmt)
+	h.errs = append(h.errs, err)
+}
+
+func (h *recordHook) MapError(_ context.Context, _ Statement, err error) error {
+	if h.mapping != nil {
+		return h.mapping
+	}
+
+	return err
+}
+
+// TestHooks
+func TestHooks(t *testing.T) {
+	failure := errors.New("unique violation")
+
+	db, _ := newFakeDB(t, func(query string, _ []any) fakeResult {
+		if strings.HasPrefix(query, "INSERT") {
+			return fakeResult{Err: failure}
+		}
+
+		return fakeResult{Columns: []string{"id"}, Rows: [][]any{{int64(1)}}, RowsAffected: 1}
+	})
+
+	var events []string
+
+	global := &recordHook{name: "global", events: &events}
+	AddHook(global)
+	defer ClearHooks()
+
+	local := &recordHook{name: "local", events: &events}
+	executor := NewExecutor(db, local)
+
+	ctx := WithLabel(context.Background(), "users.Rename")
+
+	if _, err := UpdateInstance().Update("users").Set("name", "John").Where("id", Eq, 1).ExecContext(ctx, executor); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "before global,before local,after local,after global"
+	if strings.Join(events, ",") != expected {
+		t.Fatalf(`Events %v != %s`, events, expected)
+	}
+
+	stmt := local.stmts[0]
+	if stmt.Kind != KindUpdate || stmt.Label != "users.Rename" || stmt.SQL != "UPDATE users SET name = $1 WHERE id = $2" || len(stmt.Args) != 2 {
+		t.Fatalf(`Statement %+v`, stmt)
+	}
+
+	// Only the global hooks without executor
+	if _, err := One[int64](context.Background(), db, QueryInstance().Select("id").From("users")); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(global.stmts) != 2 || len(local.stmts) != 1 || global.stmts[1].Kind != KindSelect || global.stmts[1].Label != "" {
+		t.Fatalf(`Statements %+v %+v`, global.stmts, local.stmts)
+	}
+
+	// Raw statements on the executor
+	var id int64
+	if err := executor.QueryRowContext(ctx, "SELECT id FROM users").Scan(&id); err != nil || id != 1 {
+		t.Fatalf(`Id %d (%v)`, id, err)
+	}
+
+	if local.stmts[1].Kind != KindSelect || local.stmts[1].SQL != "SELECT id FROM users" {
+		t.Fatalf(`Statement %+v`, local.stmts[1])
+	}
+
+	// Error mapping
+	domainErr := errors.New("email already used")
+	local.mapping = domainErr
+
+	_, err := InsertInstance().Insert("users", "email").Row("john@example.com").ExecContext(ctx, executor)
+	if !errors.Is(err, domainErr) {
+		t.Fatalf(`Error %v != %v`, err, domainErr)
+	}
+
+	if !errors.Is(local.errs[2], failure) {
+		t.Fatalf(`Hook error %v != %v`, local.errs[2], failure)
+	}
+
+	// Transactions keep the hooks of the executor
+	err = InTx(ctx, executor, nil, func(tx Runner) error {
+		_, err := DeleteInstance().Delete("users").Where("id", Eq, 1).ExecContext(ctx, tx)
+
+		return err
+	})
+	if err != nil || local.stmts[3].Kind != KindDelete {
+		t.Fatalf(`Statements %+v (%v)`, local.stmts, err)
+	}
+
+	// Generation errors are reported to the hooks and mapped, the statement does not run
+	_, err = DeleteInstance().Delete("users").ExecContext(ctx, executor)
+	if !errors.Is(err, domainErr) || !errors.Is(local.errs[4], ErrMissingWhere) {
+		t.Fatalf(`Error %v (hook %v)`, err, local.errs[4])
+	}
+
+	if err = DeleteInstance().Delete("users").QueryRowContext(ctx, executor).Scan(&id); !errors.Is(err, domainErr) {
+		t.Fatalf(`Error %v != %v`, err, domainErr)
+	}
+
+	if !errors.Is(local.errs[5], ErrMissingWhere) {
+		t.Fatalf(`Hook error %v != %v`, local.errs[5], ErrMissingWhere)
+	}
+}
+
+// TestSlogHook
+func TestSlogHook(t *testing.T) {
+	db, _ := newFakeDB(t, nil)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	ctx := context.Background()
+	update := UpdateInstance().Update("users").Set("password", "secret").Where("id", Eq, 1)
+
+	if _, err := update.ExecContext(ctx, NewExecutor(db, NewSlogHook(logger, SlogHookOptions{}))); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "level=DEBUG") || !strings.Contains(buf.String(), "args=2") || strings.Contains(buf.String(), "secret") {
+		t.Fatalf(`Log %s`, buf.String())
+	}
+
+	buf.Reset()
+
+	slowHook := NewSlogHook(logger, SlogHookOptions{SlowThreshold: time.Nanosecond, LogArgs: true})
+	if _, err := update.ExecContext(ctx, NewExecutor(db, slowHook)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "level=WARN") || !strings.Contains(buf.String(), "slow=true") || !strings.Contains(buf.String(), "secret") {
+		t.Fatalf(`Log %s`, buf.String())
+	}
+	buf.Reset()
+
+	infoHook := NewSlogHook(logger, SlogHookOptions{Level: slog.LevelInfo})
+	if _, err := update.ExecContext(ctx, NewExecutor(db, infoHook)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "level=INFO") {
+		t.Fatalf(`Log %s`, buf.String())
+	}
+}
@@ -1,9 +1,5 @@
 package fluentsql
 
-import (
-	"strings"
-)
-
 // ====================================================================
 //                   Insert Builder :: Structure
 // ====================================================================
@@ -65,6 +61,8 @@ type InsertBuilder struct {
 	rowStatement InsertRows
 	// queryStatement represents a subquery for the INSERT statement.
 	queryStatement InsertQuery
+	// unscoped disables the scopes of the executed statement, see Unscoped.
+	unscoped bool
 }
 
 // InsertInstance creates and returns a new instance of InsertBuilder.
@@ -77,6 +75,24 @@ func InsertInstance() *InsertBuilder {
 	return &InsertBuilder{}
 }
 
+// Clone returns a deep copy of the InsertBuilder instance, including the rows and the subquery.
+//
+// Returns:
+//
+//	*InsertBuilder - A new instance of the InsertBuilder structure.
+func (ib *InsertBuilder) Clone() *InsertBuilder {
+	if ib == nil {
+		return nil
+	}
+
+	return &InsertBuilder{
+		insertStatement: ib.insertStatement.clone(),
+		rowStatement:    ib.rowStatement.clone(),
+		queryStatement:  InsertQuery{Query: cloneValue(ib.queryStatement.Query)},
+		unscoped:        ib.unscoped,
+	}
+}
+
 // ====================================================================
 //                   Insert Builder :: Operators
 // ====================================================================
@@ -88,28 +104,34 @@ func InsertInstance() *InsertBuilder {
 //
 //	string - A string representation of the SQL INSERT statement.
 func (ib *InsertBuilder) String() string {
-	var queryParts []string
-	var sqlStr string
-
-	// Append the INSERT clause.
-	queryParts = append(queryParts, ib.insertStatement.String())
-
-	// Append the ROWS clause if present.
-	sqlStr = ib.rowStatement.String()
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
-	}
+	return inlineString(ib)
+}
 
-	// Append the QUERY clause if present.
-	sqlStr = ib.queryStatement.String()
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
-	}
+// InsertClause returns the INSERT clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//
+//	*Insert - The clause, modifying it modifies the builder.
+func (ib *InsertBuilder) InsertClause() *Insert {
+	return &ib.insertStatement
+}
 
-	// Combine all parts into a single SQL string.
-	sql := strings.Join(queryParts, " ")
+// RowsClause returns the VALUES rows of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//
+//	*InsertRows - The clause, modifying it modifies the builder.
+func (ib *InsertBuilder) RowsClause() *InsertRows {
+	return &ib.rowStatement
+}
 
-	return sql
+// QueryClause returns the INSERT ... SELECT query of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+//
+//	*InsertQuery - The clause, modifying it modifies the builder.
+func (ib *InsertBuilder) QueryClause() *InsertQuery {
+	return &ib.queryStatement
 }
 
 // Insert sets the table name and column names for the INSERT statement.
@@ -1,16 +1,11 @@
 package fluentsql
 
-import (
-	"fmt"
-	"strings"
-)
-
 // Sql retrieves the SQL INSERT statement and its arguments.
 //
 // Returns:
 //   - string: The complete SQL INSERT statement.
 //   - []any: A slice containing the arguments for the statement.
-//   - error: An error value (always nil in this implementation).
+//   - error: The error of a value which cannot be bound, e.g. ErrParamList.
 func (ib *InsertBuilder) Sql() (string, []any, error) {
 	var args []any
 
@@ -27,29 +22,31 @@ func (ib *InsertBuilder) Sql() (string, []any, error) {
 //   - []any: A slice containing the arguments for the statement.
 //   - error: An error value (always nil in this implementation).
 func (ib *InsertBuilder) StringArgs(args []any) (string, []any, error) {
-	var queryParts []string
-	var sqlStr string
+	r := newRenderer(args)
+	ib.render(r)
+
+	return r.finishStatement()
+}
 
+// render writes the INSERT statement.
+//
+// Parameters:
+//   - r *renderer: The render context.
+func (ib *InsertBuilder) render(r *renderer) {
 	// Generate SQL string and arguments for the INSERT clause.
-	sqlStr, args = ib.insertStatement.StringArgs(args)
-	queryParts = append(queryParts, sqlStr)
+	ib.insertStatement.render(r)
 
 	// Generate SQL string and arguments for the VALUES clause.
-	sqlStr, args = ib.rowStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if len(ib.rowStatement.Rows) > 0 {
+		r.writeByte(' ')
+		ib.rowStatement.render(r)
 	}
 
 	// Generate SQL string and arguments for the SUBQUERY clause.
-	sqlStr, args = ib.queryStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if _, ok := ib.queryStatement.Query.(*QueryBuilder); ok {
+		r.writeByte(' ')
+		ib.queryStatement.render(r)
 	}
-
-	// Combine all parts into a complete SQL INSERT statement.
-	sql := strings.Join(queryParts, " ")
-
-	return sql, args, nil
 }
 
 // StringArgs generates the SQL INSERT statement for a table with specified columns.
@@ -61,8 +58,19 @@ func (ib *InsertBuilder) StringArgs(args []any) (string, []any, error) {
 //   - string: The SQL INSERT statement for the table and columns.
 //   - []any: The updated slice of arguments.
 func (i *Insert) StringArgs(args []any) (string, []any) {
-	columnsStr := strings.Join(i.Columns, ", ")
-	return fmt.Sprintf("INSERT INTO %s (%s)", i.Table, columnsStr), args
+	return bindStringArgs(i, args)
+}
+
+// render writes the INSERT INTO clause with the table and its columns.
+//
+// Parameters:
+//   - r *renderer: The render context.
+func (i *Insert) render(r *renderer) {
+	r.write("INSERT INTO ")
+	r.write(i.Table)
+	r.write(" (")
+	r.writeList(i.Columns)
+	r.writeByte(')')
 }
 
 // StringArgs generates the VALUES clause for the INSERT statement, including all rows.
@@ -74,21 +82,34 @@ func (i *Insert) StringArgs(args []any) (string, []any) {
 //   - string: The SQL VALUES clause.
 //   - []any: The updated slice of arguments.
 func (r *InsertRows) StringArgs(args []any) (string, []any) {
-	var rowsStr []string
-	var sqlStr string
+	return bindStringArgs(r, args)
+}
 
-	// Process each row in the VALUES clause.
-	for _, row := range r.Rows {
-		sqlStr, args = row.StringArgs(args)
-		rowsStr = append(rowsStr, sqlStr)
+// render writes the VALUES clause, nothing when there is no row.
+//
+// Parameters:
+//   - rr *renderer: The render context.
+func (r *InsertRows) render(rr *renderer) {
+	if len(r.Rows) == 0 {
+		return
 	}
 
-	// Return empty string if no rows are specified.
-	if len(rowsStr) == 0 {
-		return "", args
+	rr.write("VALUES ")
+
+	// The shape of a statement does not depend on the number of rows
+	if rr.shape {
+		rr.write("(...)")
+
+		return
 	}
 
-	return fmt.Sprintf("VALUES %s", strings.Join(rowsStr, ", ")), args
+	for i := range r.Rows {
+		if i > 0 {
+			rr.write(", ")
+		}
+
+		r.Rows[i].render(rr)
+	}
 }
 
 // StringArgs generates the SQL string for a single row of values and updates the arguments slice.
@@ -100,24 +121,18 @@ func (r *InsertRows) StringArgs(args []any) (string, []any) {
 //   - string: The string representation of the row's values.
 //   - []any: The updated slice of arguments.
 func (ir *InsertRow) StringArgs(args []any) (string, []any) {
-	var rowStr []string
-
-	// Process each value in the row.
-	for _, col := range ir.Values {
-		if colField, ok := col.(ValueField); ok { // Value is of type ValueField.
-			rowStr = append(rowStr, colField.String())
-		} else if colString, ok := col.(string); ok { // Value is a string.
-			args = append(args, colString)
-			colStr := p(args)
-			rowStr = append(rowStr, colStr)
-		} else { // Value is of type int or float.
-			args = append(args, col)
-			colStr := p(args)
-			rowStr = append(rowStr, colStr)
-		}
-	}
+	return bindStringArgs(ir, args)
+}
 
-	return fmt.Sprintf("(%s)", strings.Join(rowStr, ", ")), args
+// render writes the parenthesized values of the row.
+// NULL, DEFAULT, ValueField and expressions are kept as SQL, other values are bound as arguments.
+//
+// Parameters:
+//   - r *renderer: The render context.
+func (ir *InsertRow) render(r *renderer) {
+	r.writeByte('(')
+	r.values(ir.Values)
+	r.writeByte(')')
 }
 
 // StringArgs generates the SQL string for the subquery in the INSERT statement.
@@ -129,14 +144,15 @@ func (ir *InsertRow) StringArgs(args []any) (string, []any) {
 //   - string: The SQL string for the subquery.
 //   - []any: The updated slice of arguments.
 func (q *InsertQuery) StringArgs(args []any) (string, []any) {
-	if queryBuilder, ok := q.Query.(*QueryBuilder); ok {
-		var sqlStr string
+	return bindStringArgs(q, args)
+}
 
-		// Generate SQL string and arguments for the subquery.
-		sqlStr, args, _ = queryBuilder.StringArgs(args)
-		return sqlStr, args
+// render writes the subquery of the INSERT statement, nothing when there is none.
+//
+// Parameters:
+//   - r *renderer: The render context.
+func (q *InsertQuery) render(r *renderer) {
+	if queryBuilder, ok := q.Query.(*QueryBuilder); ok {
+		queryBuilder.renderStatement(r)
 	}
-
-	// Return empty string if no subquery is specified.
-	return "", args
 }
@@ -1,9 +1,6 @@
 package fluentsql
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 type JoinType int
 
@@ -50,6 +47,14 @@ func (j *JoinItem) opt() string {
 	return sign
 }
 
+// String converts the join entry into a SQL-compatible join string.
+//
+// Returns:
+//   - string: A SQL string representing the join, e.g. LEFT JOIN orders ON orders.user_id = users.id.
+func (j *JoinItem) String() string {
+	return inlineString(j)
+}
+
 // Join represents a collection of join statements used in a SQL query.
 // Fields:
 //   - Items: A slice of JoinItem representing all join statements.
@@ -71,20 +76,60 @@ func (j *Join) Append(item JoinItem) {
 //   - string: A SQL string representing the join clauses.
 //     Returns an empty string if there are no join items.
 func (j *Join) String() string {
-	if len(j.Items) == 0 {
-		return ""
-	}
+	return inlineString(j)
+}
+
+// fromItem is a table reference of a FROM or USING list with the outer joins attached to it.
+// Fields:
+//   - From: The table or nested query of the list entry.
+//   - Join: The outer joins chained to the entry.
+type fromItem struct {
+	From From
+	Join Join
+}
+
+// asFromList converts the join items into a FROM or USING list entries for the dialects
+// whose UPDATE and DELETE statements cannot join the target table directly (PostgreSQL, SQLite).
+// INNER and CROSS joins become plain list entries and their ON conditions are returned so that
+// they can be moved to the WHERE clause. Outer joins stay attached to the preceding entry.
+//
+// Parameters:
+//   - items ([]fromItem): The list entries which precede the join items, e.g. UPDATE ... FROM table.
+//
+// Returns:
+//   - []fromItem: The FROM or USING list entries.
+//   - []Condition: The join conditions to be added to the WHERE clause.
+//   - error: ErrOuterJoin if an outer join has no preceding entry, it is then listed as a plain entry.
+func (j *Join) asFromList(items []fromItem) ([]fromItem, []Condition, error) {
+	var (
+		conditions []Condition
+		err        error
+	)
 
-	var joinItems []string
 	for _, item := range j.Items {
-		joinStr := fmt.Sprintf("%s %s ON %s", item.opt(), item.Table, item.Condition.String())
+		isOuter := item.Join == LeftJoin || item.Join == RightJoin || item.Join == FullOuterJoin
 
-		if item.Join == CrossJoin {
-			joinStr = fmt.Sprintf("%s %s", item.opt(), item.Table)
+		// An outer join needs a preceding entry to be attached to
+		if isOuter {
+			if len(items) > 0 {
+				items[len(items)-1].Join.Append(item)
+
+				continue
+			}
+
+			if err == nil {
+				err = fmt.Errorf("%w: %s %s", ErrOuterJoin, item.opt(), item.Table)
+			}
 		}
 
-		joinItems = append(joinItems, joinStr)
+		items = append(items, fromItem{
+			From: From{Table: item.Table},
+		})
+
+		if item.Join != CrossJoin {
+			conditions = append(conditions, item.Condition)
+		}
 	}
 
-	return strings.Join(joinItems, " ")
+	return items, conditions, err
 }
@@ -2,7 +2,6 @@ package fluentsql
 
 import (
 	"fmt"
-	"strings"
 )
 
 // OrderByDir represents the sorting direction.
@@ -18,14 +17,31 @@ const (
 	Desc                   // Descending order.
 )
 
+// NullsOrder represents the position of NULL values in a sorted column.
+//
+// Values:
+// - NullsDefault: The position of the database, NULL values are the largest in PostgreSQL and the smallest in MySQL and SQLite.
+// - NullsFirst: NULL values come first.
+// - NullsLast: NULL values come last.
+type NullsOrder int
+
+// Constants representing the positions of NULL values.
+const (
+	NullsDefault NullsOrder = iota // Position of the database.
+	NullsFirst                     // NULL values first (NULLS FIRST).
+	NullsLast                      // NULL values last (NULLS LAST).
+)
+
 // SortItem defines a single field and its sorting direction for the ORDER BY clause.
 //
 // Fields:
 // - Field (string): The name of the field to sort by.
 // - Direction (OrderByDir): The direction of sorting (Asc or Desc).
+// - Nulls (NullsOrder): The position of NULL values.
 type SortItem struct {
 	Field     string     // The field to sort by.
 	Direction OrderByDir // The direction of the sort (Asc or Desc).
+	Nulls     NullsOrder // The position of NULL values (NullsDefault, NullsFirst or NullsLast).
 }
 
 // OrderBy represents the ORDER BY clause of a SQL query.
@@ -53,6 +69,54 @@ func (o *SortItem) Dir() string {
 	return sign
 }
 
+// String returns the SQL representation of the sort item.
+// MySQL has no NULLS FIRST / NULLS LAST, the position is emulated by sorting on `field IS NULL` first.
+//
+// Returns:
+// - string: The field followed by its direction, e.g. "name ASC NULLS LAST".
+func (o *SortItem) String() string {
+	if o.Nulls == NullsDefault {
+		return fmt.Sprintf("%s %s", o.Field, o.Dir())
+	}
+
+	if IsDialect(MySQL) {
+		nullsDir := "ASC"
+		if o.Nulls == NullsFirst {
+			nullsDir = "DESC"
+		}
+
+		return fmt.Sprintf("%s IS NULL %s, %s %s", o.Field, nullsDir, o.Field, o.Dir())
+	}
+
+	nulls := "NULLS LAST"
+	if o.Nulls == NullsFirst {
+		nulls = "NULLS FIRST"
+	}
+
+	return fmt.Sprintf("%s %s %s", o.Field, o.Dir(), nulls)
+}
+
+// nullsLast reports whether the NULL values of the sort item come last, according to the current dialect
+// when the position is NullsDefault.
+//
+// Returns:
+// - bool: True if NULL values come after the other values.
+func (o *SortItem) nullsLast() bool {
+	switch o.Nulls {
+	case NullsFirst:
+		return false
+	case NullsLast:
+		return true
+	}
+
+	// PostgreSQL sorts NULL values as the largest values, MySQL and SQLite as the smallest.
+	if IsDialect(PostgreSQL) {
+		return o.Direction == Asc
+	}
+
+	return o.Direction == Desc
+}
+
 // Append adds a new field and its sorting direction to the ORDER BY clause.
 //
 // Parameters:
@@ -71,17 +135,5 @@ func (o *OrderBy) Append(field string, dir OrderByDir) {
 // Returns:
 // - string: The constructed ORDER BY clause. Returns an empty string if no fields are specified.
 func (o *OrderBy) String() string {
-	// Return empty string if no items are present.
-	if len(o.Items) == 0 {
-		return ""
-	}
-
-	var orderItems []string // Holds individual order by items in string format.
-	for _, item := range o.Items {
-		// Construct field-direction pair and append to the list.
-		orderItems = append(orderItems, fmt.Sprintf("%s %s", item.Field, item.Dir()))
-	}
-
-	// Join all items and prefix with "ORDER BY".
-	return fmt.Sprintf("ORDER BY %s", strings.Join(orderItems, ", "))
+	return inlineString(o)
 }
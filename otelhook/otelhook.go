@@ -0,0 +1,224 @@
+// Package otelhook instruments the statements executed by fluentsql builders with OpenTelemetry.
+// Each statement is traced by one span and recorded in a latency histogram and an error counter.
+//
+// Example:
+//
+//	executor, err := otelhook.Wrap(db, otelhook.Options{})
+//	if err != nil {
+//	    return err
+//	}
+//
+//	users, err := fluentsql.All[User](ctx, executor, query)
+package otelhook
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jivegroup/fluentsql"
+)
+
+// instrumentationName is the name of the tracer and the meter.
+const instrumentationName = "github.com/jivegroup/fluentsql/otelhook"
+
+// Attribute keys of the spans and the measurements.
+const (
+	DBSystem    = attribute.Key("db.system")    // Database system, from Dialect.Name()
+	DBOperation = attribute.Key("db.operation") // Builder kind: select, insert, update, delete or raw
+	DBTable     = attribute.Key("db.sql.table") // Main table of the builder
+	DBStatement = attribute.Key("db.statement") // Sanitized statement
+	DBLabel     = attribute.Key("db.label")     // Caller label of fluentsql.WithLabel
+)
+
+// Options defines the providers of the instrumentation.
+type Options struct {
+	// TracerProvider creates the tracer, the global provider if nil.
+	TracerProvider trace.TracerProvider
+	// MeterProvider creates the meter, the global provider if nil.
+	MeterProvider metric.MeterProvider
+	// DisableStatement omits the db.statement attribute of the spans.
+	DisableStatement bool
+}
+
+// hook is the fluentsql.Hook emitting the spans and measurements.
+type hook struct {
+	tracer           trace.Tracer
+	duration         metric.Float64Histogram
+	errors           metric.Int64Counter
+	disableStatement bool
+}
+
+// literalPattern matches the string and numeric literals left in a statement, e.g. by Expr.
+var literalPattern = regexp.MustCompile(`'(?:[^']|'')*'|\b\d+(?:\.\d+)?\b`)
+
+// NewHook creates a fluentsql.Hook instrumenting the statements with OpenTelemetry.
+//
+// Parameters:
+//   - opts (Options): The tracer and meter providers.
+//
+// Returns:
+//   - fluentsql.Hook: The hook, to be registered with fluentsql.AddHook or fluentsql.NewExecutor.
+//   - error: An error if the instruments cannot be created.
+func NewHook(opts Options) (fluentsql.Hook, error) {
+	tracerProvider := opts.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+
+	meterProvider := opts.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := meterProvider.Meter(instrumentationName)
+
+	duration, err := meter.Float64Histogram("db.client.operation.duration",
+		metric.WithDescription("Duration of the database statements."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	errorCounter, err := meter.Int64Counter("db.client.operation.errors",
+		metric.WithDescription("Number of failed database statements."),
+		metric.WithUnit("{error}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook{
+		tracer:           tracerProvider.Tracer(instrumentationName),
+		duration:         duration,
+		errors:           errorCounter,
+		disableStatement: opts.DisableStatement,
+	}, nil
+}
+
+// Wrap wraps a Runner with an executor instrumented with OpenTelemetry.
+//
+// Parameters:
+//   - runner (fluentsql.Runner): A *sql.DB, *sql.Tx, *sql.Conn or another executor.
+//   - opts (Options): The tracer and meter providers.
+//
+// Returns:
+//   - *fluentsql.Executor: The instrumented executor.
+//   - error: An error if the instruments cannot be created.
+func Wrap(runner fluentsql.Runner, opts Options) (*fluentsql.Executor, error) {
+	h, err := NewHook(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return fluentsql.NewExecutor(runner, h), nil
+}
+
+// BeforeQuery starts the span of the statement.
+func (h *hook) BeforeQuery(ctx context.Context, stmt fluentsql.Statement) context.Context {
+	attrs := attributes(stmt)
+
+	if !h.disableStatement {
+		attrs = append(attrs, DBStatement.String(Sanitize(stmt.SQL)))
+	}
+
+	if stmt.Label != "" {
+		attrs = append(attrs, DBLabel.String(stmt.Label))
+	}
+
+	ctx, _ = h.tracer.Start(ctx, spanName(stmt),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+
+	return ctx
+}
+
+// AfterQuery ends the span of the statement and records its duration and error.
+func (h *hook) AfterQuery(ctx context.Context, stmt fluentsql.Statement, _ sql.Result, err error, duration time.Duration) {
+	set := metric.WithAttributes(attributes(stmt)...)
+
+	h.duration.Record(ctx, duration.Seconds(), set)
+
+	span := trace.SpanFromContext(ctx)
+
+	if err != nil {
+		h.errors.Add(ctx, 1, set)
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// attributes returns the attributes shared by the spans and the measurements.
+//
+// Parameters:
+//   - stmt (fluentsql.Statement): The statement.
+//
+// Returns:
+//   - []attribute.KeyValue: The system, operation and table attributes.
+func attributes(stmt fluentsql.Statement) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		DBSystem.String(strings.ToLower(fluentsql.DefaultDialect().Name())),
+		DBOperation.String(string(stmt.Kind)),
+	}
+
+	if stmt.Table != "" {
+		attrs = append(attrs, DBTable.String(stmt.Table))
+	}
+
+	return attrs
+}
+
+// spanName returns the name of the span of a statement, e.g. "select users".
+//
+// Parameters:
+//   - stmt (fluentsql.Statement): The statement.
+//
+// Returns:
+//   - string: The operation followed by the table, if any.
+func spanName(stmt fluentsql.Statement) string {
+	if stmt.Table == "" {
+		return string(stmt.Kind)
+	}
+
+	return string(stmt.Kind) + " " + stmt.Table
+}
+
+// Sanitize replaces the string and numeric literals of a statement with question marks.
+// The arguments of the builders are placeholders already, literals come from raw SQL or Expr.
+//
+// Parameters:
+//   - sqlStr (string): The statement.
+//
+// Returns:
+//   - string: The statement without literal values.
+func Sanitize(sqlStr string) string {
+	var sb strings.Builder
+
+	last := 0
+
+	for _, match := range literalPattern.FindAllStringIndex(sqlStr, -1) {
+		// Keep the PostgreSQL placeholders such as $1
+		if match[0] > 0 && sqlStr[match[0]-1] == '$' {
+			continue
+		}
+
+		sb.WriteString(sqlStr[last:match[0]])
+		sb.WriteString("?")
+
+		last = match[1]
+	}
+
+	sb.WriteString(sqlStr[last:])
+
+	return sb.String()
+}
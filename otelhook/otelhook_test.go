@@ -0,0 +1,145 @@
+package otelhook
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/jivegroup/fluentsql"
+)
+
+// stubRunner answers ExecContext with err.
+type stubRunner struct {
+	err error
+}
+
+func (r stubRunner) ExecContext(_ context.Context, _ string, _ ...any) (sql.Result, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func (r stubRunner) QueryContext(_ context.Context, _ string, _ ...any) (*sql.Rows, error) {
+	return nil, r.err
+}
+
+func (r stubRunner) QueryRowContext(_ context.Context, _ string, _ ...any) *sql.Row {
+	return nil
+}
+
+// TestHook
+func TestHook(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	ctx := fluentsql.WithLabel(context.Background(), "users.Rename")
+	opts := Options{TracerProvider: tracerProvider, MeterProvider: meterProvider}
+
+	executor, err := Wrap(stubRunner{}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = fluentsql.UpdateInstance().
+		Update("users").
+		Set("name", "John").
+		Set("visits", fluentsql.Expr("visits + 1")).
+		Where("id", fluentsql.Eq, 1).
+		ExecContext(ctx, executor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failing, _ := Wrap(stubRunner{err: errors.New("connection refused")}, opts)
+
+	if _, err = fluentsql.DeleteInstance().Delete("users").Where("id", fluentsql.Eq, 1).ExecContext(ctx, failing); err == nil {
+		t.Fatalf(`Delete must fail`)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf(`Spans %d != 2`, len(spans))
+	}
+
+	if spans[0].Name != "update users" || spans[0].Status.Code == codes.Error {
+		t.Fatalf(`Span %s %v`, spans[0].Name, spans[0].Status)
+	}
+
+	expected := map[attribute.Key]string{
+		DBSystem:    "postgresql",
+		DBOperation: "update",
+		DBTable:     "users",
+		DBStatement: "UPDATE users SET name = $1, visits = visits + ? WHERE id = $2",
+		DBLabel:     "users.Rename",
+	}
+
+	for _, attr := range spans[0].Attributes {
+		if value, ok := expected[attr.Key]; ok && attr.Value.AsString() != value {
+			t.Fatalf(`Attribute %s %s != %s`, attr.Key, attr.Value.AsString(), value)
+		}
+
+		delete(expected, attr.Key)
+	}
+
+	if len(expected) != 0 {
+		t.Fatalf(`Missing attributes %v`, expected)
+	}
+
+	if spans[1].Name != "delete users" || spans[1].Status.Code != codes.Error {
+		t.Fatalf(`Span %s %v`, spans[1].Name, spans[1].Status)
+	}
+
+	var metrics metricdata.ResourceMetrics
+	if err = reader.Collect(ctx, &metrics); err != nil {
+		t.Fatal(err)
+	}
+
+	var durations, failures int64
+
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Histogram[float64]:
+				for _, point := range data.DataPoints {
+					durations += int64(point.Count)
+				}
+			case metricdata.Sum[int64]:
+				for _, point := range data.DataPoints {
+					failures += point.Value
+				}
+			}
+		}
+	}
+
+	if durations != 2 || failures != 1 {
+		t.Fatalf(`Durations %d, failures %d`, durations, failures)
+	}
+}
+
+// TestSanitize
+func TestSanitize(t *testing.T) {
+	testCases := map[string]string{
+		"SELECT * FROM users WHERE name = ? AND age > ? LIMIT ?":        "SELECT * FROM users WHERE name = 'O''Brien' AND age > 30 LIMIT 10",
+		"SELECT * FROM t1 WHERE id = $1 AND price > ?":                  "SELECT * FROM t1 WHERE id = $1 AND price > 9.99",
+		"UPDATE users SET name = $1, visits = visits + ? WHERE id = $2": "UPDATE users SET name = $1, visits = visits + 1 WHERE id = $2",
+	}
+
+	for expected, sqlStr := range testCases {
+		if Sanitize(sqlStr) != expected {
+			t.Fatalf(`Query %s != %s`, Sanitize(sqlStr), expected)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package fluentsql
+
+import (
+	"context"
+	"reflect"
+)
+
+// pageTotalColumn is the name of the window count column added by PageOptions.WindowCount.
+const pageTotalColumn = "fluentsql_total"
+
+// Page holds a page of rows with its metadata.
+type Page[T any] struct {
+	// Items are the rows of the page.
+	Items []T
+	// Total is the number of rows of the query without pagination.
+	Total int64
+	// Page is the page number, starting at 1.
+	Page int
+	// Size is the maximum number of rows per page.
+	Size int
+	// HasNext reports whether there are rows after the page.
+	HasNext bool
+}
+
+// PageOptions defines the options of Paginate.
+type PageOptions struct {
+	// WindowCount counts the rows in the data query with COUNT(*) OVER(), in one round trip.
+	// Queries with GROUP BY, HAVING or DISTINCT always run a separate count query.
+	WindowCount bool
+	// MaxSize clamps the page size, no maximum if zero.
+	MaxSize int
+}
+
+// Paginate runs the SELECT statement for one page and counts the rows of the whole query.
+// The page and size are clamped to 1 at least, the query itself is not modified.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statements.
+//   - runner (Runner): A *sql.DB, *sql.Tx or *sql.Conn.
+//   - qb (*QueryBuilder): The SELECT statement, its LIMIT and FETCH clauses are replaced.
+//   - page (int): The page number, starting at 1.
+//   - size (int): The maximum number of rows per page.
+//   - opts (...PageOptions): Optional settings for the count and the page size.
+//
+// Returns:
+//   - Page[T]: The rows and the metadata of the page.
+//   - error: Any generation, driver or scan error.
+//
+// Example:
+//
+//	page, err := fluentsql.Paginate[User](ctx, db, query, 2, 20)
+//	// SELECT id, name FROM users ORDER BY id ASC LIMIT $1 OFFSET $2
+//	// SELECT COUNT(*) FROM users
+func Paginate[T any](ctx context.Context, runner Runner, qb *QueryBuilder, page, size int, opts ...PageOptions) (Page[T], error) {
+	var opt PageOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	// Clamp the inputs
+	page = max(page, 1)
+	size = max(size, 1)
+
+	if opt.MaxSize > 0 {
+		size = min(size, opt.MaxSize)
+	}
+
+	result := Page[T]{
+		Page: page,
+		Size: size,
+	}
+
+	query := qb.Clone()
+	query.fetchStatement = Fetch{}
+	query.Limit(size, (page-1)*size)
+
+	var err error
+
+	counted := false
+
+	if opt.WindowCount && !qb.isAggregated() {
+		result.Items, result.Total, err = pageWithTotal[T](ctx, runner, query)
+		counted = len(result.Items) > 0
+	} else {
+		result.Items, err = All[T](ctx, runner, query)
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	// The window count is missing on a page after the last row
+	if !counted {
+		if result.Total, err = One[int64](ctx, runner, qb.CountQuery()); err != nil {
+			return result, err
+		}
+	}
+
+	result.HasNext = int64(page)*int64(size) < result.Total
+
+	return result, nil
+}
+
+// pageWithTotal runs the SELECT statement with an additional COUNT(*) OVER() column.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx or *sql.Conn.
+//   - query (*QueryBuilder): The SELECT statement of the page, it is modified.
+//
+// Returns:
+//   - []T: The scanned rows.
+//   - int64: The number of rows of the query without pagination, 0 if there are no rows.
+//   - error: Any generation, driver or scan error.
+func pageWithTotal[T any](ctx context.Context, runner Runner, query *QueryBuilder) ([]T, int64, error) {
+	if len(query.selectStatement.Columns) == 0 {
+		query.selectStatement.Columns = []any{"*"}
+	}
+
+	query.selectStatement.Columns = append(query.selectStatement.Columns, "COUNT(*) OVER() AS "+pageTotalColumn)
+
+	sqlStr, args, err := query.scopedSql(ctx, runner)
+
+	rows, err := queryContext(ctx, runner, query.statement(sqlStr, args), err)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, 0, wrapError(sqlStr, err)
+	}
+
+	// The count is the last column
+	scanner, err := newColumnScanner(columns[:len(columns)-1], reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, 0, wrapError(sqlStr, err)
+	}
+
+	var items []T
+	var total int64
+
+	for rows.Next() {
+		var item T
+
+		if err = scanner.scan(rows, reflect.ValueOf(&item).Elem(), &total); err != nil {
+			return nil, 0, wrapError(sqlStr, err)
+		}
+
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, wrapError(sqlStr, err)
+	}
+
+	return items, total, wrapError(sqlStr, rows.Close())
+}
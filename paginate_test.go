@@ -0,0 +1,94 @@
+package fluentsql
+
+import (
+	"context"
+	"testing"
+)
+
+// TestPaginate
+func TestPaginate(t *testing.T) {
+	db, fake := newFakeDB(t, func(query string, args []any) fakeResult {
+		switch query {
+		case "SELECT COUNT(*) FROM users WHERE status = $1":
+			return fakeResult{Columns: []string{"count"}, Rows: [][]any{{int64(5)}}}
+		case "SELECT id, name FROM users WHERE status = $1 ORDER BY id ASC LIMIT $2 OFFSET $3":
+			if args[2] == int64(2) {
+				return fakeResult{
+					Columns: []string{"id", "name"},
+					Rows:    [][]any{{int64(3), "Jack"}, {int64(4), "Jane"}},
+				}
+			}
+		}
+
+		return fakeResult{Columns: []string{"id", "name"}}
+	})
+
+	ctx := context.Background()
+	query := QueryInstance().
+		Select("id", "name").
+		From("users").
+		Where("status", Eq, "active").
+		OrderBy("id", Asc).
+		Limit(100, 0)
+	expected := query.String()
+
+	page, err := Paginate[testUser](ctx, db, query, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Items) != 2 || page.Items[0].Name != "Jack" || page.Total != 5 || page.Page != 2 || page.Size != 2 || !page.HasNext {
+		t.Fatalf(`Page %+v`, page)
+	}
+
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	page, err = Paginate[testUser](ctx, db, query, -1, 0, PageOptions{MaxSize: 50})
+	if err != nil || page.Page != 1 || page.Size != 1 || !page.HasNext || fake.Last().Args[0] != "active" {
+		t.Fatalf(`Page %+v (%v)`, page, err)
+	}
+
+	page, err = Paginate[testUser](ctx, db, query, 3, 500, PageOptions{MaxSize: 50})
+	if err != nil || page.Size != 50 || len(page.Items) != 0 || page.Total != 5 || page.HasNext {
+		t.Fatalf(`Page %+v (%v)`, page, err)
+	}
+}
+
+// TestPaginateWindowCount
+func TestPaginateWindowCount(t *testing.T) {
+	db, fake := newFakeDB(t, func(query string, _ []any) fakeResult {
+		switch query {
+		case "SELECT id, name, COUNT(*) OVER() AS fluentsql_total FROM users ORDER BY id ASC LIMIT $1 OFFSET $2":
+			return fakeResult{
+				Columns: []string{"id", "name", "fluentsql_total"},
+				Rows:    [][]any{{int64(1), "John", int64(3)}, {int64(2), "Jane", int64(3)}},
+			}
+		case "SELECT COUNT(*) FROM (SELECT DISTINCT name FROM users) AS t":
+			return fakeResult{Columns: []string{"count"}, Rows: [][]any{{int64(2)}}}
+		}
+
+		return fakeResult{Columns: []string{"name"}, Rows: [][]any{{"John"}, {"Jane"}}}
+	})
+
+	ctx := context.Background()
+	query := QueryInstance().
+		Select("id", "name").
+		From("users").
+		OrderBy("id", Asc)
+
+	page, err := Paginate[testUser](ctx, db, query, 1, 2, PageOptions{WindowCount: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Items) != 2 || page.Items[1].Name != "Jane" || page.Total != 3 || !page.HasNext || len(fake.Queries()) != 1 {
+		t.Fatalf(`Page %+v %v`, page, fake.Queries())
+	}
+
+	names, err := Paginate[string](ctx, db, QueryInstance().Select("DISTINCT name").From("users"), 1, 10, PageOptions{WindowCount: true})
+	if err != nil || len(names.Items) != 2 || names.Total != 2 || names.HasNext {
+		t.Fatalf(`Page %+v (%v)`, names, err)
+	}
+}
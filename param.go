@@ -0,0 +1,155 @@
+package fluentsql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Param is a named parameter slot. It is used as the value of a condition, an inserted row or an
+// assignment, and kept in the arguments of Sql() so that one statement can be run with new values
+// given to Bind. The inline String() renders it as :name.
+//
+// A Param is one value: the IN conditions take a list of Params, e.g. []any{Param("a"), Param("b")},
+// and Sql returns ErrParamList for Where("id", In, Param("ids")).
+//
+// Examples:
+//   - Where("id", Eq, Param("id")) to keep SQL string as `id = :id` or `id = $1`
+//   - Named("created_at > :since", nil)
+type Param string
+
+// String generates the SQL representation of the parameter.
+//
+// Returns:
+//   - string: The name of the parameter prefixed by a colon.
+func (p Param) String() string {
+	return ":" + string(p)
+}
+
+// Value reports an unbound parameter to the driver, the arguments must be given to Bind first.
+//
+// Returns:
+//   - driver.Value: Always nil.
+//   - error: ErrMissingParam with the name of the parameter.
+func (p Param) Value() (driver.Value, error) {
+	return nil, fmt.Errorf("%w: %s is not bound", ErrMissingParam, p)
+}
+
+// Bind replaces the Param slots of the arguments of a statement with their values.
+//
+// Parameters:
+//   - args ([]any): The arguments returned by Sql().
+//   - values (map[string]any): The values of the parameters, by name.
+//
+// Returns:
+//   - []any: The arguments in placeholder order, args is not modified.
+//   - error: ErrMissingParam if a Param has no value.
+//
+// Example:
+//
+//	sqlStr, args, _ := fluentsql.QueryInstance().
+//	    Select("*").
+//	    From("users").
+//	    Where("id", fluentsql.Eq, fluentsql.Param("id")).
+//	    Sql()
+//
+//	bound, err := fluentsql.Bind(args, map[string]any{"id": 42})
+//	rows, err := db.QueryContext(ctx, sqlStr, bound...)
+func Bind(args []any, values map[string]any) ([]any, error) {
+	bound := make([]any, len(args))
+
+	for i, arg := range args {
+		param, ok := arg.(Param)
+		if !ok {
+			bound[i] = arg
+
+			continue
+		}
+
+		value, ok := values[string(param)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingParam, param)
+		}
+
+		bound[i] = value
+	}
+
+	return bound, nil
+}
+
+// Named creates an Expression from a raw SQL fragment with :name parameters. Each parameter becomes
+// a question mark bound to its value, so that it is rendered with the placeholders of the dialect.
+// A parameter without a value is kept as a Param slot, to be given to Bind.
+// The :: casts and the array slices such as a[lo:hi] of PostgreSQL, and the quoted strings are left
+// unchanged: a parameter follows neither a name nor an opening bracket.
+//
+// Parameters:
+//   - sql: The SQL fragment with :name parameters.
+//   - values: The values of the parameters, by name.
+//
+// Returns:
+//   - Expression: The SQL expression.
+//
+// Examples:
+//   - Named("age BETWEEN :min AND :max", map[string]any{"min": 18, "max": 65})
+//     to keep SQL string as `age BETWEEN 18 AND 65` or `age BETWEEN $1 AND $2`
+//   - Named("created_at > :since::date", nil) to keep SQL string as `created_at > $1::date`
+func Named(sql string, values map[string]any) Expression {
+	var (
+		sb   strings.Builder
+		args []any
+	)
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			// Copy the quoted string or identifier
+			end := strings.IndexByte(sql[i+1:], c)
+			if end < 0 {
+				sb.WriteString(sql[i:])
+
+				return Expression{SQL: sb.String(), Args: args}
+			}
+
+			sb.WriteString(sql[i : i+end+2])
+			i += end + 1
+		case c == ':' && i+1 < len(sql) && sql[i+1] == ':':
+			// Copy the cast operator
+			sb.WriteString("::")
+			i++
+		case c == ':' && i+1 < len(sql) && isNameStart(sql[i+1]) && (i == 0 || !isNamePart(sql[i-1]) && sql[i-1] != '['):
+			// A colon after a name or a bracket is an array slice, e.g. a[:lo:hi]
+			end := i + 2
+			for end < len(sql) && isNamePart(sql[end]) {
+				end++
+			}
+
+			name := sql[i+1 : end]
+
+			value, ok := values[name]
+			if !ok {
+				value = Param(name)
+			}
+
+			sb.WriteString(question)
+			args = append(args, value)
+			i = end - 1
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return Expression{SQL: sb.String(), Args: args}
+}
+
+// isNameStart reports whether a byte starts the name of a parameter.
+func isNameStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// isNamePart reports whether a byte continues the name of a parameter.
+func isNamePart(c byte) bool {
+	return isNameStart(c) || ('0' <= c && c <= '9')
+}
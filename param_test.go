@@ -0,0 +1,146 @@
+package fluentsql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestNamed
+func TestNamed(t *testing.T) {
+	testCases := map[string]Expression{
+		"age BETWEEN 18 AND 65":          Named("age BETWEEN :min AND :max", map[string]any{"min": 18, "max": 65}),
+		"name = 'John' OR nick = 'John'": Named("name = :name OR nick = :name", map[string]any{"name": "John"}),
+		"created_at > :since::date":      Named("created_at > :since::date", nil),
+		"note = ':literal' AND id = 1":   Named("note = ':literal' AND id = :id", map[string]any{"id": 1}),
+		"CURRENT_TIMESTAMP":              Named("CURRENT_TIMESTAMP", nil),
+		"a[:lo:hi] = 1":                  Named("a[:lo:hi] = :id", map[string]any{"id": 1}),
+		"tags[lo:hi] = '{}'":             Named("tags[lo:hi] = :tags", map[string]any{"tags": "{}"}),
+	}
+
+	for expected, expression := range testCases {
+		if expression.String() != expected {
+			t.Fatalf(`Expression %s != %s`, expression.String(), expected)
+		}
+	}
+}
+
+// TestNamedArgs
+func TestNamedArgs(t *testing.T) {
+	expression := Named("age BETWEEN :min AND :max AND created_at > :since::date", map[string]any{"min": 18, "max": 65})
+
+	sql, args := expression.StringArgs(nil)
+
+	expected := "age BETWEEN $1 AND $2 AND created_at > $3::date"
+	if sql != expected || !reflect.DeepEqual(args, []any{18, 65, Param("since")}) {
+		t.Fatalf(`Expression %s != %s (%v)`, sql, expected, args)
+	}
+
+	SetDialect(new(MySQLDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	sql, _ = expression.StringArgs(nil)
+
+	expected = "age BETWEEN ? AND ? AND created_at > ?::date"
+	if sql != expected {
+		t.Fatalf(`Expression %s != %s`, sql, expected)
+	}
+	// The question marks of the quoted strings are not placeholders
+	SetDialect(new(PostgreSQLDialect))
+
+	sql, args = Named("note = 'what?' AND id = :id", map[string]any{"id": 1}).StringArgs(nil)
+
+	expected = "note = 'what?' AND id = $1"
+	if sql != expected || !reflect.DeepEqual(args, []any{1}) {
+		t.Fatalf(`Expression %s != %s (%v)`, sql, expected, args)
+	}
+}
+
+// TestParam
+func TestParam(t *testing.T) {
+	testCases := map[string]interface{ String() string }{
+		"SELECT * FROM users WHERE id = :id AND status IN (:status, 'active')": QueryInstance().
+			Select("*").
+			From("users").
+			Where("id", Eq, Param("id")).
+			Where("status", In, []any{Param("status"), "active"}),
+		"INSERT INTO users (name, age) VALUES (:name, :age)": InsertInstance().
+			Insert("users", "name", "age").
+			Row(Param("name"), Param("age")),
+		"UPDATE users SET name = :name WHERE id = :id": UpdateInstance().
+			Update("users").
+			Set("name", Param("name")).
+			Where("id", Eq, Param("id")),
+	}
+
+	for expected, builder := range testCases {
+		if builder.String() != expected {
+			t.Fatalf(`Query %s != %s`, builder.String(), expected)
+		}
+	}
+}
+
+// TestBind
+func TestBind(t *testing.T) {
+	query := QueryInstance().
+		Select("*").
+		From("users").
+		Where("id", Eq, Param("id")).
+		Where("age", Greater, 18).
+		Where("name", Eq, Param("name"))
+
+	sqlStr, args, err := query.Sql()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "SELECT * FROM users WHERE id = $1 AND age > $2 AND name = $3"
+	if sqlStr != expected {
+		t.Fatalf(`Query %s != %s`, sqlStr, expected)
+	}
+
+	bound, err := Bind(args, map[string]any{"id": 1, "name": "John"})
+	if err != nil || !reflect.DeepEqual(bound, []any{1, 18, "John"}) {
+		t.Fatalf(`Bind %v (%v)`, bound, err)
+	}
+
+	// The slots are kept for the next values
+	bound, _ = Bind(args, map[string]any{"id": 2, "name": "Jane"})
+	if !reflect.DeepEqual(bound, []any{2, 18, "Jane"}) {
+		t.Fatalf(`Bind %v`, bound)
+	}
+
+	if _, err = Bind(args, map[string]any{"id": 1}); !errors.Is(err, ErrMissingParam) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingParam)
+	}
+}
+
+// TestParamUnbound
+func TestParamUnbound(t *testing.T) {
+	db, _ := newFakeDB(t, nil)
+
+	_, err := DeleteInstance().Delete("users").Where("id", Eq, Param("id")).ExecContext(context.Background(), db)
+	if !errors.Is(err, ErrMissingParam) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingParam)
+	}
+}
+
+// TestParamList
+func TestParamList(t *testing.T) {
+	_, _, err := QueryInstance().Select("*").From("users").Where("id", In, Param("ids")).Sql()
+	if !errors.Is(err, ErrParamList) {
+		t.Fatalf(`Error %v != %v`, err, ErrParamList)
+	}
+
+	if _, _, err = DeleteInstance().Delete("users").Where("id", NotIn, Param("ids")).Sql(); !errors.Is(err, ErrParamList) {
+		t.Fatalf(`Error %v != %v`, err, ErrParamList)
+	}
+
+	sql, args, err := QueryInstance().Select("*").From("users").Where("id", In, []any{Param("a"), Param("b")}).Sql()
+
+	expected := "SELECT * FROM users WHERE id IN ($1, $2)"
+	if err != nil || sql != expected || !reflect.DeepEqual(args, []any{Param("a"), Param("b")}) {
+		t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+	}
+}
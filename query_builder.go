@@ -1,7 +1,6 @@
 package fluentsql
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -75,6 +74,15 @@ type QueryBuilder struct {
 
 	// fetchStatement represents a FETCH clause, an alternative to LIMIT.
 	fetchStatement Fetch
+
+	// unscoped disables the scopes of the executed statement, see Unscoped.
+	unscoped bool
+
+	// trashed selects the soft deleted rows, see WithTrashed and OnlyTrashed.
+	trashed trashedMode
+
+	// err keeps the first error of the builder methods, it is returned by Sql.
+	err error
 }
 
 // QueryInstance creates and returns a new instance of QueryBuilder.
@@ -85,6 +93,39 @@ func QueryInstance() *QueryBuilder {
 	return &QueryBuilder{}
 }
 
+// Clone returns a deep copy of the QueryBuilder instance. Every clause is copied, including
+// nested queries, CASE expressions and condition groups, so the copy can be changed without
+// affecting the instance.
+//
+// Returns:
+// - *QueryBuilder: A new QueryBuilder instance.
+//
+// Examples:
+//
+//	var activeUsers = QueryInstance().Select("id", "name").From("users").Where("status", Eq, "active")
+//	admins := activeUsers.Clone().Where("role", Eq, "admin")
+func (qb *QueryBuilder) Clone() *QueryBuilder {
+	if qb == nil {
+		return nil
+	}
+
+	return &QueryBuilder{
+		alias:            qb.alias,
+		selectStatement:  qb.selectStatement.clone(),
+		fromStatement:    qb.fromStatement.clone(),
+		joinStatement:    qb.joinStatement.clone(),
+		whereStatement:   qb.whereStatement.clone(),
+		groupByStatement: qb.groupByStatement.clone(),
+		havingStatement:  qb.havingStatement.clone(),
+		orderByStatement: qb.orderByStatement.clone(),
+		limitStatement:   qb.limitStatement,
+		fetchStatement:   qb.fetchStatement,
+		unscoped:         qb.unscoped,
+		trashed:          qb.trashed,
+		err:              qb.err,
+	}
+}
+
 // ====================================================================
 //                   Query Builder :: Operators
 // ====================================================================
@@ -94,66 +135,84 @@ func QueryInstance() *QueryBuilder {
 // Returns:
 // - string: The SQL query string representation of the QueryBuilder.
 func (qb *QueryBuilder) String() string {
-	var queryParts []string
-
-	// Append SELECT clause
-	// Append FROM clause
-	queryParts = append(queryParts,
-		qb.selectStatement.String(),
-		qb.fromStatement.String(),
-	)
-
-	// Append JOIN clauses
-	joinSql := qb.joinStatement.String()
-	if joinSql != "" {
-		queryParts = append(queryParts, joinSql)
-	}
+	r := newInlineRenderer()
+	qb.renderStatement(r)
 
-	// Append WHERE clause
-	whereSql := qb.whereStatement.String()
-	if whereSql != "" {
-		queryParts = append(queryParts, whereSql)
-	}
+	sql, _ := r.finish()
 
-	// Append GROUP BY clause
-	groupSql := qb.groupByStatement.String()
-	if groupSql != "" {
-		queryParts = append(queryParts, groupSql)
-	}
+	return sql
+}
 
-	// Append HAVING clause
-	havingSql := qb.havingStatement.String()
-	if havingSql != "" {
-		queryParts = append(queryParts, havingSql)
-	}
+// SelectClause returns the SELECT clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Select: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) SelectClause() *Select {
+	return &qb.selectStatement
+}
 
-	// Append ORDER BY clause
-	orderBySql := qb.orderByStatement.String()
-	if orderBySql != "" {
-		queryParts = append(queryParts, orderBySql)
-	}
+// FromClause returns the FROM clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *From: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) FromClause() *From {
+	return &qb.fromStatement
+}
 
-	// Append LIMIT clause
-	limitSql := qb.limitStatement.String()
-	if limitSql != "" {
-		queryParts = append(queryParts, limitSql)
-	}
+// JoinClause returns the JOIN clauses of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Join: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) JoinClause() *Join {
+	return &qb.joinStatement
+}
 
-	// Append FETCH clause
-	fetchSql := qb.fetchStatement.String()
-	if fetchSql != "" {
-		queryParts = append(queryParts, fetchSql)
-	}
+// WhereClause returns the WHERE clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Where: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) WhereClause() *Where {
+	return &qb.whereStatement
+}
 
-	// Join all parts with a space
-	sql := strings.Join(queryParts, " ")
+// GroupByClause returns the GROUP BY clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *GroupBy: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) GroupByClause() *GroupBy {
+	return &qb.groupByStatement
+}
 
-	// Add alias if provided
-	if qb.alias != "" {
-		sql = fmt.Sprintf("(%s) AS %s", sql, qb.alias)
-	}
+// HavingClause returns the HAVING clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Having: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) HavingClause() *Having {
+	return &qb.havingStatement
+}
 
-	return sql
+// OrderByClause returns the ORDER BY clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *OrderBy: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) OrderByClause() *OrderBy {
+	return &qb.orderByStatement
+}
+
+// LimitClause returns the LIMIT clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Limit: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) LimitClause() *Limit {
+	return &qb.limitStatement
+}
+
+// FetchClause returns the FETCH clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Fetch: The clause, modifying it modifies the builder.
+func (qb *QueryBuilder) FetchClause() *Fetch {
+	return &qb.fetchStatement
 }
 
 // Select defines the SELECT clause of the query.
@@ -319,6 +378,24 @@ func (qb *QueryBuilder) OrderBy(field string, dir OrderByDir) *QueryBuilder {
 	return qb
 }
 
+// OrderByNulls defines the ORDER BY clause of the query with the position of NULL values.
+//
+// Parameters:
+// - field string: The field to sort by.
+// - dir OrderByDir: The direction of sorting (ASC or DESC).
+// - nulls NullsOrder: The position of NULL values (NullsFirst or NullsLast).
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated ORDER BY clause.
+func (qb *QueryBuilder) OrderByNulls(field string, dir OrderByDir, nulls NullsOrder) *QueryBuilder {
+	qb.orderByStatement.Items = append(qb.orderByStatement.Items, SortItem{
+		Field:     field,
+		Direction: dir,
+		Nulls:     nulls,
+	})
+	return qb
+}
+
 // Limit sets the LIMIT clause of the query.
 //
 // Parameters:
@@ -379,6 +456,55 @@ func (qb *QueryBuilder) RemoveFetch() Fetch {
 	return _fetchStatement
 }
 
+// CountQuery derives a query counting the rows of the QueryBuilder instance, the instance is not modified.
+// ORDER BY, LIMIT and FETCH are dropped and the select list is replaced with COUNT(*).
+// A query with GROUP BY, HAVING or DISTINCT is wrapped as a derived table instead.
+//
+// Returns:
+// - *QueryBuilder: A new QueryBuilder instance counting the rows.
+//
+// Examples:
+//
+//	SELECT COUNT(*) FROM users WHERE status = 'active'
+//	SELECT COUNT(*) FROM (SELECT department_id FROM employees GROUP BY department_id) AS t
+func (qb *QueryBuilder) CountQuery() *QueryBuilder {
+	query := qb.Clone()
+
+	query.alias = ""
+	query.orderByStatement = OrderBy{}
+	query.limitStatement = Limit{}
+	query.fetchStatement = Fetch{}
+
+	if qb.isAggregated() {
+		return QueryInstance().
+			Select("COUNT(*)").
+			From(query.AS("t"))
+	}
+
+	query.selectStatement.Columns = []any{"COUNT(*)"}
+
+	return query
+}
+
+// isAggregated reports whether the rows of the query are groups or distinct values,
+// which cannot be counted by replacing the select list.
+//
+// Returns:
+// - bool: True if the query has GROUP BY, HAVING or a DISTINCT select list.
+func (qb *QueryBuilder) isAggregated() bool {
+	if len(qb.groupByStatement.Items) > 0 || len(qb.havingStatement.Conditions) > 0 {
+		return true
+	}
+
+	if len(qb.selectStatement.Columns) > 0 {
+		if column, ok := qb.selectStatement.Columns[0].(string); ok {
+			return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(column)), "DISTINCT")
+		}
+	}
+
+	return false
+}
+
 // AS sets an alias for the entire QueryBuilder instance.
 //
 // Parameters:
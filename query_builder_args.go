@@ -3,7 +3,6 @@ package fluentsql
 import (
 	"fmt"
 	"reflect"
-	"strings"
 )
 
 // Sql constructs the SQL query string and associated arguments.
@@ -29,59 +28,91 @@ func (qb *QueryBuilder) Sql() (string, []any, error) {
 // - []any: A slice containing all arguments for the query.
 // - error: Any error encountered during query string construction.
 func (qb *QueryBuilder) StringArgs(args []any) (string, []any, error) {
-	var queryParts []string // Slice to hold the parts of the query
-	var sqlStr string       // Variable to store the current query part
+	r := newRenderer(args)
 
-	sqlStr, args = qb.selectStatement.StringArgs(args)
-	queryParts = append(queryParts, sqlStr)
+	if err := qb.render(r); err != nil {
+		r.release()
 
-	sqlStr, args = qb.fromStatement.StringArgs(args)
-	queryParts = append(queryParts, sqlStr)
+		return "", nil, err
+	}
+
+	return r.finishStatement()
+}
+
+// render writes the SELECT statement, wrapped in parentheses with its alias if any.
+//
+// Parameters:
+// - r *renderer: The render context.
+//
+// Returns:
+// - error: The error of a builder method, nothing is written then.
+func (qb *QueryBuilder) render(r *renderer) error {
+	if qb.err != nil {
+		return qb.err
+	}
+
+	qb.renderStatement(r)
 
-	sqlStr, args = qb.joinStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	return nil
+}
+
+// renderStatement writes the SELECT statement without checking the error of the builder methods,
+// e.g. for String() and the subqueries.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (qb *QueryBuilder) renderStatement(r *renderer) {
+	if qb.alias != "" {
+		r.writeByte('(')
 	}
 
-	sqlStr, args = qb.whereStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	qb.selectStatement.render(r)
+
+	// The FROM clause is kept as an empty part when there is no table
+	r.writeByte(' ')
+	qb.fromStatement.render(r)
+
+	joinStatement, whereStatement := qb.softDeleteClauses()
+
+	if len(joinStatement.Items) > 0 {
+		r.writeByte(' ')
+		joinStatement.render(r)
 	}
 
-	sqlStr, args = qb.groupByStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if len(whereStatement.Conditions) > 0 {
+		r.writeByte(' ')
+		whereStatement.render(r)
 	}
 
-	sqlStr, args = qb.havingStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if len(qb.groupByStatement.Items) > 0 {
+		r.writeByte(' ')
+		qb.groupByStatement.render(r)
 	}
 
-	sqlStr, args = qb.orderByStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if len(qb.havingStatement.Conditions) > 0 {
+		r.writeByte(' ')
+		qb.havingStatement.render(r)
 	}
 
-	sqlStr, args = qb.limitStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if len(qb.orderByStatement.Items) > 0 {
+		r.writeByte(' ')
+		qb.orderByStatement.render(r)
 	}
 
-	sqlStr, args = qb.fetchStatement.StringArgs(args)
-	if sqlStr != "" {
-		queryParts = append(queryParts, sqlStr)
+	if qb.limitStatement.Limit > 0 || qb.limitStatement.Offset > 0 {
+		r.writeByte(' ')
+		qb.limitStatement.render(r)
 	}
 
-	sqlStr = strings.Join(queryParts, " ") // Combine all query parts into a single string
+	if qb.fetchStatement.Fetch > 0 || qb.fetchStatement.Offset > 0 {
+		r.writeByte(' ')
+		qb.fetchStatement.render(r)
+	}
 
 	if qb.alias != "" {
-		sqlStr = fmt.Sprintf("(%s) AS %s",
-			sqlStr,
-			qb.alias)
+		r.write(") AS ")
+		r.write(qb.alias)
 	}
-
-	return sqlStr, args, nil
 }
 
 // StringArgs generates the SQL SELECT statement string and associated arguments.
@@ -93,40 +124,55 @@ func (qb *QueryBuilder) StringArgs(args []any) (string, []any, error) {
 // - string: The complete SQL SELECT statement as a string.
 // - []any: A slice containing the arguments used in the query.
 func (s *Select) StringArgs(args []any) (string, []any) {
-	selectOf := "*" // Default to selecting all columns
-
-	if len(s.Columns) > 0 {
-		var columns []string
-
-		// Iterate through each column to process its type and generate the corresponding SQL part
-		for _, col := range s.Columns {
-			var sqlPart string
-			if _, ok := col.(*Case); ok { // Column is of type Case
-				sqlPart, args = col.(*Case).StringArgs(args)
-				columns = append(columns, sqlPart)
-			} else if valueString, ok := col.(string); ok { // Column is a plain string
-				columns = append(columns, valueString)
-			} else if valueFieldYear, ok := col.(FieldYear); ok { // Column is of type FieldYear
-				columns = append(columns, valueFieldYear.String())
-			} else if valueQueryBuilder, ok := col.(*QueryBuilder); ok { // Column is a QueryBuilder
-				var selectQuery string
-				selectQuery, args, _ = valueQueryBuilder.StringArgs(args)
-
-				// Wrap the query in parentheses if no alias is provided
-				if col.(*QueryBuilder).alias == "" {
-					selectQuery = fmt.Sprintf("(%s)", selectQuery)
-				}
-
-				columns = append(columns, selectQuery)
+	return bindStringArgs(s, args)
+}
+
+// render writes the SELECT clause, * when no column is selected.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (s *Select) render(r *renderer) {
+	r.write("SELECT ")
+
+	if len(s.Columns) == 0 {
+		r.writeByte('*')
+
+		return
+	}
+
+	written := false
+
+	// Process each column according to its type, the other types are skipped
+	for _, col := range s.Columns {
+		switch col.(type) {
+		case *Case, string, FieldYear, *QueryBuilder:
+			if written {
+				r.write(", ")
 			}
+
+			written = true
+		default:
+			continue
 		}
 
-		// Combine all column representations into a comma-separated string
-		selectOf = strings.Join(columns, ", ")
+		switch column := col.(type) {
+		case *Case:
+			column.render(r)
+		case string:
+			r.write(column)
+		case FieldYear:
+			r.write(column.String())
+		case *QueryBuilder:
+			// Wrap the query in parentheses if no alias is provided
+			if column.alias == "" {
+				r.writeByte('(')
+				column.renderStatement(r)
+				r.writeByte(')')
+			} else {
+				column.renderStatement(r)
+			}
+		}
 	}
-
-	// Return the constructed SELECT statement and associated arguments
-	return fmt.Sprintf("SELECT %s", selectOf), args
 }
 
 // StringArgs generates the SQL FROM clause string and associated arguments.
@@ -138,30 +184,46 @@ func (s *Select) StringArgs(args []any) (string, []any) {
 // - string: The SQL FROM clause string.
 // - []any: A slice containing the arguments used in the clause.
 func (f *From) StringArgs(args []any) (string, []any) {
-	var sb strings.Builder // String builder for constructing the FROM clause
+	return bindStringArgs(f, args)
+}
 
-	// Process the table source based on its type
-	if _, ok := f.Table.(string); ok { // Table is a plain string
-		sb.WriteString(fmt.Sprintf("FROM %s", f.Table))
-	} else if _, ok := f.Table.(*QueryBuilder); ok { // Table is a QueryBuilder
-		var selectQuery string
-		selectQuery, args, _ = f.Table.(*QueryBuilder).StringArgs(args)
+// render writes the FROM clause, nothing when there is no table.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (f *From) render(r *renderer) {
+	if f.Table == nil {
+		return
+	}
 
+	r.write("FROM ")
+	f.renderTable(r)
+}
+
+// renderTable writes the table reference of the FROM clause without the FROM keyword.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (f *From) renderTable(r *renderer) {
+	switch table := f.Table.(type) {
+	case string:
+		r.write(table)
+	case *QueryBuilder:
 		// Wrap the query in parentheses if no alias is provided
-		if f.Table.(*QueryBuilder).alias == "" {
-			sb.WriteString(fmt.Sprintf("FROM (%s)", selectQuery))
+		if table.alias == "" {
+			r.writeByte('(')
+			table.renderStatement(r)
+			r.writeByte(')')
 		} else {
-			sb.WriteString(fmt.Sprintf("FROM %s", selectQuery))
+			table.renderStatement(r)
 		}
 	}
 
 	// Append the table alias if provided
 	if f.Alias != "" {
-		sb.WriteString(" " + f.Alias)
+		r.writeByte(' ')
+		r.write(f.Alias)
 	}
-
-	// Return the constructed FROM clause and associated arguments
-	return sb.String(), args
 }
 
 // StringArgs generates the SQL JOIN clause string and associated arguments.
@@ -173,31 +235,37 @@ func (f *From) StringArgs(args []any) (string, []any) {
 // - string: The SQL JOIN clause string. Returns an empty string if there are no JOIN items.
 // - []any: A slice containing the arguments used in the clause.
 func (j *Join) StringArgs(args []any) (string, []any) {
-	// Return empty string if there are no join items
-	if len(j.Items) == 0 {
-		return "", args
-	}
-
-	var joinItems []string // Slice to hold each join statement
-
-	// Process each join item to generate the full join statement
-	for _, item := range j.Items {
-		var cond string
-		cond, args = item.Condition.StringArgs(args)
-
-		// Construct the join string based on the type of JOIN
-		joinStr := fmt.Sprintf("%s %s ON %s", item.opt(), item.Table, cond)
+	return bindStringArgs(j, args)
+}
 
-		// For CROSS JOIN, omit the ON clause
-		if item.Join == CrossJoin {
-			joinStr = fmt.Sprintf("%s %s", item.opt(), item.Table)
+// render writes the JOIN items separated by spaces.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (j *Join) render(r *renderer) {
+	for i, item := range j.Items {
+		if i > 0 {
+			r.writeByte(' ')
 		}
 
-		joinItems = append(joinItems, joinStr)
+		item.render(r)
 	}
+}
 
-	// Combine all join statements into a single string and return
-	return strings.Join(joinItems, " "), args
+// render writes a join entry.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (j *JoinItem) render(r *renderer) {
+	r.write(j.opt())
+	r.writeByte(' ')
+	r.write(j.Table)
+
+	// For CROSS JOIN, omit the ON clause
+	if j.Join != CrossJoin {
+		r.write(" ON ")
+		j.Condition.render(r)
+	}
 }
 
 // StringArgs generates the SQL WHERE clause string and associated arguments.
@@ -209,33 +277,39 @@ func (j *Join) StringArgs(args []any) (string, []any) {
 // - string: The complete SQL WHERE clause string. Returns an empty string if no conditions are present.
 // - []any: A slice containing the arguments used in the WHERE clause.
 func (w *Where) StringArgs(args []any) (string, []any) {
-	var conditions []string // Slice to hold individual condition strings.
+	return bindStringArgs(w, args)
+}
 
-	// Process each condition in the Where struct.
-	if len(w.Conditions) > 0 {
-		for _, cond := range w.Conditions {
-			var _condition string
-			_condition, args = cond.StringArgs(args)
+// render writes the WHERE clause, nothing when there is no condition.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (w *Where) render(r *renderer) {
+	if len(w.Conditions) == 0 {
+		return
+	}
 
-			// Handle "OR" conditions.
-			if cond.AndOr == Or && len(conditions) > 0 {
-				_orCondition := fmt.Sprint(" OR ", _condition)
+	r.write("WHERE ")
+	renderConditions(r, w.Conditions)
+}
 
-				last := len(conditions) - 1
-				conditions[last] += _orCondition
+// renderConditions writes conditions joined with AND, or with OR for the conditions marked so.
+//
+// Parameters:
+// - r *renderer: The render context.
+// - conditions []Condition: The conditions.
+func renderConditions(r *renderer, conditions []Condition) {
+	for i := range conditions {
+		if i > 0 {
+			if conditions[i].AndOr == Or {
+				r.write(" OR ")
 			} else {
-				conditions = append(conditions, _condition)
+				r.write(" AND ")
 			}
 		}
-	}
 
-	// Return an empty string if no conditions exist.
-	if len(conditions) == 0 {
-		return "", args
+		conditions[i].render(r)
 	}
-
-	// Construct the WHERE clause by joining conditions with "AND".
-	return fmt.Sprintf("WHERE %s", strings.Join(conditions, " AND ")), args
 }
 
 // StringArgs generates the SQL condition string and associated arguments.
@@ -247,109 +321,109 @@ func (w *Where) StringArgs(args []any) (string, []any) {
 // - string: The SQL condition as a string.
 // - []any: A slice containing the arguments used in the condition.
 func (c *Condition) StringArgs(args []any) (string, []any) {
+	return bindStringArgs(c, args)
+}
+
+// render writes the condition, a parenthesized group or a field compared to a value.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (c *Condition) render(r *renderer) {
 	// Handle group conditions (nested conditions).
 	if len(c.Group) > 0 {
-		var conditions []string // Slice to store grouped condition strings.
-
-		for _, cond := range c.Group {
-			var _condition string
-			_condition, args = cond.StringArgs(args)
+		r.writeByte('(')
+		renderConditions(r, c.Group)
+		r.writeByte(')')
 
-			// Handle "OR" conditions within the group.
-			if cond.AndOr == Or && len(conditions) > 0 {
-				_orCondition := fmt.Sprint(" OR ", _condition)
-
-				last := len(conditions) - 1
-				conditions[last] += _orCondition
-			} else {
-				conditions = append(conditions, _condition)
-			}
-		}
-
-		// Return an empty string if no conditions are in the group.
-		if len(conditions) == 0 {
-			return "", args
-		}
-
-		return fmt.Sprintf("(%s)", strings.Join(conditions, " AND ")), args
+		return
 	}
 
+	r.writeAny(c.Field)
+	r.writeByte(' ')
+	r.write(c.opt())
+
 	// Handle ValueField type, excluding it from arguments.
 	if valueField, ok := c.Value.(ValueField); ok {
-		return fmt.Sprintf("%s %s %s", c.Field, c.opt(), valueField), args
-	}
+		r.writeByte(' ')
+		r.write(valueField.String())
 
-	// Handle IS NULL and IS NOT NULL conditions.
-	if c.Opt == Null || c.Opt == NotNull {
-		return fmt.Sprintf("%s %s", c.Field, c.opt()), args
+		return
 	}
 
-	// Handle IN and NOT IN conditions.
-	if c.Opt == In || c.Opt == NotIn {
-		typ := reflect.TypeOf(c.Value)
-
-		if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
-			var valuesStr []string // Slice to store stringified values.
-
-			// Process string slices.
-			if values, ok := c.Value.([]string); ok {
-				for _, val := range values {
-					args = append(args, val)
-					valuesStr = append(valuesStr, p(args))
-				}
+	switch c.Opt {
+	case Null, NotNull:
+		// Handle IS NULL and IS NOT NULL conditions.
+		return
+	case In, NotIn:
+		// Handle IN and NOT IN conditions, each value of the slice is bound.
+		if isList(c.Value) {
+			// The shape of a statement does not depend on the length of the list
+			if r.shape {
+				r.write(" (...)")
+
+				return
 			}
 
-			// Process integer slices.
-			if values, ok := c.Value.([]int); ok {
-				for _, val := range values {
-					args = append(args, val)
-					valuesStr = append(valuesStr, p(args))
-				}
-			}
+			r.write(" (")
+			r.values(c.Value)
+			r.writeByte(')')
 
-			return fmt.Sprintf("%s %s (%s)", c.Field, c.opt(), strings.Join(valuesStr, ", ")), args
+			return
 		}
-	}
 
-	// Handle BETWEEN and NOT BETWEEN conditions.
-	// WHERE Price BETWEEN 10 AND 20;
-	// WHERE ProductName BETWEEN 'Carnation Tigers' AND 'Mozzarella di Giovanni'
-	// WHERE Price NOT BETWEEN 10 AND 20;
-	// WHERE ProductName NOT BETWEEN 'Carnation Tigers' AND 'Mozzarella di Giovanni'
-	// WHERE Price BETWEEN 10 AND 20
-	if c.Opt == Between || c.Opt == NotBetween {
-		var betweenValue string
-		betweenValue, args = c.Value.(ValueBetween).StringArgs(args)
+		// A list cannot be bound to one placeholder
+		if param, ok := c.Value.(Param); ok && !r.inline {
+			r.fail(fmt.Errorf("%w: %v %s %s, use a list of Params", ErrParamList, c.Field, c.opt(), param))
+		}
+	case Between, NotBetween:
+		// Handle BETWEEN and NOT BETWEEN conditions.
+		r.writeByte(' ')
+		c.Value.(ValueBetween).render(r)
 
-		return fmt.Sprintf("%s %s %v", c.Field, c.opt(), betweenValue), args
+		return
 	}
 
-	// Handle subqueries and nested QueryBuilder objects.
-	// WHERE salary = (SELECT DISTINCT salary FROM employees ORDER BY salary DESC LIMIT 1 , 1);
-	// WHERE CustomerID IN (SELECT CustomerID FROM Orders);
-	// WHERE CustomerID NOT IN (SELECT CustomerID FROM Orders);
-	// WHERE EXISTS (SELECT ProductName FROM Products);
-	// WHERE NOT EXISTS (SELECT ProductName FROM Products);
-	// WHERE ProductID = ANY (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
-	// WHERE ProductID > ALL (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
-	if valueQueryBuilder, ok := c.Value.(*QueryBuilder); ok {
-		var queryBuilderStr string
-		queryBuilderStr, args, _ = valueQueryBuilder.StringArgs(args)
-
-		return fmt.Sprintf("%s %s (%v)", c.Field, c.opt(), queryBuilderStr), args
+	r.writeByte(' ')
+
+	switch value := c.Value.(type) {
+	case *QueryBuilder:
+		// Handle subqueries and nested QueryBuilder objects.
+		// WHERE CustomerID IN (SELECT CustomerID FROM Orders);
+		// WHERE EXISTS (SELECT ProductName FROM Products);
+		// WHERE ProductID = ANY (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
+		r.writeByte('(')
+		value.renderStatement(r)
+		r.writeByte(')')
+	case Expression:
+		// Handle expressions, their arguments are bound.
+		value.render(r)
+	case nil:
+		// A missing value is bound as NULL.
+		r.bind(nil)
+	default:
+		// Handle CASE expressions, parameters and all other value types.
+		r.value(c.Value)
 	}
+}
 
-	// Handle string values directly.
-	if valueString, ok := c.Value.(string); ok {
-		args = append(args, valueString)
-
-		return fmt.Sprintf("%s %s %s", c.Field, c.opt(), p(args)), args
+// isList reports whether a value is a slice or an array, []any without reflection.
+//
+// Parameters:
+// - value any: The value of a condition.
+//
+// Returns:
+// - bool: True for a slice or an array.
+func isList(value any) bool {
+	switch value.(type) {
+	case nil:
+		return false
+	case []any, []string, []int, []int64:
+		return true
 	}
 
-	// Handle all other value types.
-	args = append(args, c.Value)
+	kind := reflect.TypeOf(value).Kind()
 
-	return fmt.Sprintf("%s %s %s", c.Field, c.opt(), p(args)), args
+	return kind == reflect.Slice || kind == reflect.Array
 }
 
 // StringArgs generates the SQL representation for a ValueBetween range
@@ -362,34 +436,32 @@ func (c *Condition) StringArgs(args []any) (string, []any) {
 // - string: The SQL representation of the range in the format "LOW_PLACEHOLDER AND HIGH_PLACEHOLDER".
 // - []any: The updated slice of arguments, including Low and High values.
 func (v ValueBetween) StringArgs(args []any) (string, []any) {
-	// Append lower bound to arguments and get its placeholder.
-	args = append(args, v.Low)
-	pLow := p(args)
-
-	// Append upper bound to arguments and get its placeholder.
-	args = append(args, v.High)
-	pHigh := p(args)
+	return bindStringArgs(v, args)
+}
 
-	// Return SQL representation and updated arguments.
-	// hire_date BETWEEN '1999-01-01' AND '2000-12-31'
-	// salary NOT BETWEEN 2500 AND 2900
-	return fmt.Sprintf("%v AND %v", pLow, pHigh), args
+// render writes the bounds of the range, both bound as arguments.
+// hire_date BETWEEN '1999-01-01' AND '2000-12-31'
+// salary NOT BETWEEN 2500 AND 2900
+//
+// Parameters:
+// - r *renderer: The render context.
+func (v ValueBetween) render(r *renderer) {
+	r.bind(v.Low)
+	r.write(" AND ")
+	r.bind(v.High)
 }
 
-// StringArgs generates the SQL representation for extracting a year value
-// from a field and adds the field value to the arguments slice.
+// StringArgs generates the SQL representation for extracting a year value from a field.
 //
 // Parameters:
-// - args []any: The input slice to which the field value will be appended.
+// - args []any: The input slice of arguments (unused in this case).
 //
 // Returns:
 // - string: The SQL representation for the year extraction, customized for the database type.
-// - []any: The updated slice of arguments, including the field value.
+// - []any: The unchanged slice of arguments.
 func (v FieldYear) StringArgs(args []any) (string, []any) {
-	// Append the field value to arguments and get its placeholder.
-	args = append(args, string(v))
-
-	return DefaultDialect().YearFunction(p(args)), args
+	// The field is a column, it is not bound as an argument.
+	return v.String(), args
 }
 
 // StringArgs generates the SQL GROUP BY clause string.
@@ -401,13 +473,20 @@ func (v FieldYear) StringArgs(args []any) (string, []any) {
 // - string: The SQL GROUP BY clause string. Returns an empty string if no items are present.
 // - []any: The unchanged slice of arguments.
 func (g *GroupBy) StringArgs(args []any) (string, []any) {
-	// Return empty if there are no group by items.
+	return bindStringArgs(g, args)
+}
+
+// render writes the GROUP BY clause, nothing when there is no item.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (g *GroupBy) render(r *renderer) {
 	if len(g.Items) == 0 {
-		return "", args
+		return
 	}
 
-	// Construct and return GROUP BY clause.
-	return fmt.Sprintf("GROUP BY %s", strings.Join(g.Items, ", ")), args
+	r.write("GROUP BY ")
+	r.writeList(g.Items)
 }
 
 // StringArgs generates the SQL HAVING clause string and appends the associated argument values.
@@ -419,34 +498,20 @@ func (g *GroupBy) StringArgs(args []any) (string, []any) {
 // - string: The SQL HAVING clause string, combining conditions with "AND". Returns an empty string if no conditions are present.
 // - []any: The updated slice of arguments, including condition values.
 func (w *Having) StringArgs(args []any) (string, []any) {
-	var conditions []string
-
-	// Process each HAVING condition.
-	if len(w.Conditions) > 0 {
-		for _, cond := range w.Conditions {
-			// Generate SQL and update arguments for each condition.
-			var _condition string
-			_condition, args = cond.StringArgs(args)
-
-			// Handle "OR" conditions.
-			if cond.AndOr == Or && len(conditions) > 0 {
-				_orCondition := fmt.Sprint(" OR ", _condition)
-
-				last := len(conditions) - 1
-				conditions[last] += _orCondition
-			} else {
-				conditions = append(conditions, _condition)
-			}
-		}
-	}
+	return bindStringArgs(w, args)
+}
 
-	// Return empty string if no conditions exist.
-	if len(conditions) == 0 {
-		return "", args
+// render writes the HAVING clause, nothing when there is no condition.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (w *Having) render(r *renderer) {
+	if len(w.Conditions) == 0 {
+		return
 	}
 
-	// Construct HAVING clause by joining conditions with "AND".
-	return fmt.Sprintf("HAVING %s", strings.Join(conditions, " AND ")), args
+	r.write("HAVING ")
+	renderConditions(r, w.Conditions)
 }
 
 // StringArgs generates the SQL ORDER BY clause string.
@@ -458,19 +523,37 @@ func (w *Having) StringArgs(args []any) (string, []any) {
 // - string: The SQL ORDER BY clause string. Returns an empty string if no items are present.
 // - []any: The unchanged slice of arguments.
 func (o *OrderBy) StringArgs(args []any) (string, []any) {
-	// Return empty if there are no order by items.
+	return bindStringArgs(o, args)
+}
+
+// render writes the ORDER BY clause, nothing when there is no item.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (o *OrderBy) render(r *renderer) {
 	if len(o.Items) == 0 {
-		return "", args
+		return
 	}
 
-	var orderItems []string
-	// Process each ORDER BY item.
-	for _, item := range o.Items {
-		orderItems = append(orderItems, fmt.Sprintf("%s %s", item.Field, item.Dir()))
-	}
+	r.write("ORDER BY ")
 
-	// Construct and return ORDER BY clause.
-	return fmt.Sprintf("ORDER BY %s", strings.Join(orderItems, ", ")), args
+	for i := range o.Items {
+		if i > 0 {
+			r.write(", ")
+		}
+
+		item := &o.Items[i]
+
+		if item.Nulls != NullsDefault {
+			r.write(item.String())
+
+			continue
+		}
+
+		r.write(item.Field)
+		r.writeByte(' ')
+		r.write(item.Dir())
+	}
 }
 
 // StringArgs generates the SQL LIMIT and OFFSET clause strings
@@ -483,19 +566,35 @@ func (o *OrderBy) StringArgs(args []any) (string, []any) {
 // - string: The SQL LIMIT and OFFSET clause string. Returns an empty string if both values are zero.
 // - []any: The updated slice of arguments, including limit and offset values.
 func (l *Limit) StringArgs(args []any) (string, []any) {
-	// Append limit and offset values, and generate placeholders.
-	if l.Limit > 0 || l.Offset > 0 {
-		args = append(args, l.Limit)
-		pLimit := p(args)
-		args = append(args, l.Offset)
-		pOffset := p(args)
+	return bindStringArgs(l, args)
+}
 
-		// Construct and return LIMIT/OFFSET clause.
-		return fmt.Sprintf("LIMIT %s OFFSET %s", pLimit, pOffset), args
+// render writes the LIMIT and OFFSET clause, nothing when both values are zero.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (l *Limit) render(r *renderer) {
+	if l.Limit <= 0 && l.Offset <= 0 {
+		return
 	}
 
-	// Return empty string if no limit or offset is set.
-	return "", args
+	r.write("LIMIT ")
+	r.bind(l.Limit)
+	r.write(" OFFSET ")
+	r.bind(l.Offset)
+}
+
+// renderRowCount writes the LIMIT clause without OFFSET, nothing when Limit is zero.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (l *Limit) renderRowCount(r *renderer) {
+	if l.Limit <= 0 {
+		return
+	}
+
+	r.write("LIMIT ")
+	r.bind(l.Limit)
 }
 
 // StringArgs generates the SQL FETCH NEXT ROWS clause string
@@ -508,19 +607,23 @@ func (l *Limit) StringArgs(args []any) (string, []any) {
 // - string: The SQL FETCH NEXT ROWS clause string. Returns an empty string if both values are zero.
 // - []any: The updated slice of arguments, including fetch and offset values.
 func (f *Fetch) StringArgs(args []any) (string, []any) {
-	// Append fetch and offset values, and generate placeholders.
-	if f.Fetch > 0 || f.Offset > 0 {
-		args = append(args, f.Offset)
-		pOffset := p(args)
-		args = append(args, f.Fetch)
-		pFetch := p(args)
+	return bindStringArgs(f, args)
+}
 
-		// Construct and return FETCH NEXT ROWS clause.
-		return fmt.Sprintf("OFFSET %s ROWS FETCH NEXT %s ROWS ONLY", pOffset, pFetch), args
+// render writes the OFFSET and FETCH NEXT ROWS clause, nothing when both values are zero.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (f *Fetch) render(r *renderer) {
+	if f.Fetch <= 0 && f.Offset <= 0 {
+		return
 	}
 
-	// Return empty string if no fetch or offset is set.
-	return "", args
+	r.write("OFFSET ")
+	r.bind(f.Offset)
+	r.write(" ROWS FETCH NEXT ")
+	r.bind(f.Fetch)
+	r.write(" ROWS ONLY")
 }
 
 // StringArgs generates the SQL WHEN clause string for a CASE statement
@@ -533,25 +636,34 @@ func (f *Fetch) StringArgs(args []any) (string, []any) {
 // - string: The SQL WHEN clause string.
 // - []any: The updated slice of arguments, including value and condition values.
 func (c *WhenCase) StringArgs(args []any) (string, []any) {
-	// Append the value associated with the WHEN clause.
-	args = append(args, c.Value)
+	return bindStringArgs(c, args)
+}
 
-	// Process conditions and construct the WHEN clause SQL.
-	if valueConditions, ok := c.Conditions.([]Condition); ok {
-		var cons []string
-		for _, condition := range valueConditions {
-			var sqlPart string
-			sqlPart, args = condition.StringArgs(args)
+// render writes the WHEN clause: the conditions joined with AND, an expression or a raw value,
+// followed by the THEN value.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (c *WhenCase) render(r *renderer) {
+	r.write("WHEN ")
+
+	switch conditions := c.Conditions.(type) {
+	case []Condition:
+		for i := range conditions {
+			if i > 0 {
+				r.write(" AND ")
+			}
 
-			cons = append(cons, sqlPart)
+			conditions[i].render(r)
 		}
-
-		// Construct and return the WHEN clause.
-		return fmt.Sprintf("WHEN %s THEN '?'", strings.Join(cons, " AND ")), args
+	case Expression:
+		conditions.render(r)
+	default:
+		r.buf = fmt.Append(r.buf, c.Conditions)
 	}
 
-	// Return the WHEN clause for a single condition.
-	return fmt.Sprintf("WHEN %v THEN '?'", c.Conditions), args
+	r.write(" THEN ")
+	r.value(c.Value)
 }
 
 // StringArgs generates the SQL CASE statement string
@@ -564,16 +676,50 @@ func (c *WhenCase) StringArgs(args []any) (string, []any) {
 // - string: The SQL CASE statement string.
 // - []any: The updated slice of arguments, including expression and WHEN clause values.
 func (c *Case) StringArgs(args []any) (string, []any) {
-	var whenCases []string
+	return bindStringArgs(c, args)
+}
 
-	// Process each WHEN clause in the CASE statement.
-	for _, whenClause := range c.WhenClauses {
-		var sqlPart string
-		sqlPart, args = whenClause.StringArgs(args)
+// render writes the CASE expression followed by its name if any.
+//
+// Parameters:
+// - r *renderer: The render context.
+func (c *Case) render(r *renderer) {
+	r.write("CASE ")
+	r.write(c.Exp)
+	r.writeByte(' ')
+
+	for i, whenClause := range c.simpleWhenClauses() {
+		if i > 0 {
+			r.writeByte(' ')
+		}
 
-		whenCases = append(whenCases, sqlPart)
+		whenClause.render(r)
 	}
 
-	// Construct and return the CASE statement.
-	return fmt.Sprintf("CASE %s %s END %s", c.Exp, strings.Join(whenCases, " "), c.Name), args
+	r.write(" END")
+
+	if c.Name != "" {
+		r.writeByte(' ')
+		r.write(c.Name)
+	}
+}
+
+// renderFromList writes the entries of a FROM or USING list separated by commas.
+//
+// Parameters:
+// - r *renderer: The render context.
+// - items []fromItem: The list entries.
+func renderFromList(r *renderer, items []fromItem) {
+	for i := range items {
+		if i > 0 {
+			r.write(", ")
+		}
+
+		items[i].From.renderTable(r)
+
+		if len(items[i].Join.Items) > 0 {
+			r.writeByte(' ')
+			items[i].Join.render(r)
+		}
+	}
 }
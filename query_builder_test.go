@@ -675,3 +675,61 @@ func TestRemoveFetch(t *testing.T) {
 		t.Fatalf(`Query %s != %s`, query.String(), sqlLimit)
 	}
 }
+
+// TestCountQuery
+func TestCountQuery(t *testing.T) {
+	testCases := map[string]*QueryBuilder{
+		"SELECT COUNT(*) FROM users WHERE status = 'active'": QueryInstance().
+			Select("id", "name").
+			From("users").
+			Where("status", Eq, "active").
+			OrderBy("name", Asc).
+			Limit(10, 20),
+		"SELECT COUNT(*) FROM users u INNER JOIN companies c ON c.id = u.company_id": QueryInstance().
+			Select("u.id", "c.name").
+			From("users", "u").
+			Join(InnerJoin, "companies c", Condition{
+				Field: "c.id",
+				Opt:   Eq,
+				Value: ValueField("u.company_id"),
+			}).
+			Fetch(0, 5),
+		"SELECT COUNT(*) FROM (SELECT department_id, COUNT(*) FROM employees GROUP BY department_id HAVING COUNT(*) > 2) AS t": QueryInstance().
+			Select("department_id", "COUNT(*)").
+			From("employees").
+			GroupBy("department_id").
+			Having("COUNT(*)", Greater, 2).
+			OrderBy("department_id", Asc),
+		"SELECT COUNT(*) FROM (SELECT DISTINCT salary FROM employees) AS t": QueryInstance().
+			Select("DISTINCT salary").
+			From("employees").
+			Limit(1, 0),
+	}
+
+	for expected, query := range testCases {
+		original := query.String()
+		count := query.CountQuery()
+
+		if count.String() != expected {
+			t.Fatalf(`Query %s != %s`, count.String(), expected)
+		}
+
+		count.Where("id", Greater, 1)
+
+		if query.String() != original {
+			t.Fatalf(`Query %s != %s`, query.String(), original)
+		}
+	}
+
+	query := QueryInstance().
+		Select("id").
+		From("users").
+		Where("status", Eq, "active").
+		Limit(10, 0)
+	expected := "SELECT COUNT(*) FROM users WHERE status = $1"
+
+	sql, args, _ := query.CountQuery().Sql()
+	if sql != expected || len(args) != 1 {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+}
@@ -0,0 +1,329 @@
+package fluentsql
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// ====================================================================
+//                   Renderer :: Structure
+// ====================================================================
+
+// renderer is the render context of a statement. All the clauses of a statement, including its
+// subqueries, write into one buffer and append their arguments to one slice. The renderers are pooled,
+// so that a statement allocates its resulting string and arguments only.
+//
+// String() and StringArgs() share the same render methods: in inline mode the values are written as
+// SQL literals, in bind mode they are replaced by placeholders and appended to the arguments.
+type renderer struct {
+	buf     []byte  // buf is the generated SQL.
+	args    []any   // args are the arguments of the placeholders.
+	dialect Dialect // dialect generates the placeholders.
+	inline  bool    // inline writes the values as literals instead of binding them.
+	shape   bool    // shape writes the values as ? and collapses the IN lists and VALUES rows, see Fingerprint.
+	err     error   // err is the first error of the render, e.g. a value which cannot be bound.
+}
+
+// renderable is a clause, condition or value which writes itself into a renderer.
+type renderable interface {
+	render(r *renderer)
+}
+
+// placeholderAppender is implemented by the dialects which write their placeholders without allocating.
+type placeholderAppender interface {
+	appendPlaceholder(buf []byte, position int) []byte
+}
+
+// maxPooledBuffer is the capacity above which the buffer of a renderer is not kept in the pool.
+const maxPooledBuffer = 64 << 10
+
+// rendererPool holds the released renderers.
+var rendererPool = sync.Pool{
+	New: func() any {
+		return &renderer{buf: make([]byte, 0, 256)}
+	},
+}
+
+// ====================================================================
+//                   Renderer :: Operators
+// ====================================================================
+
+// newRenderer takes a renderer from the pool.
+//
+// Parameters:
+//   - args ([]any): The arguments of the enclosing statement, the new arguments are appended.
+//
+// Returns:
+//   - *renderer: The renderer with an empty buffer and the dialect of the package.
+func newRenderer(args []any) *renderer {
+	r := rendererPool.Get().(*renderer)
+	r.args = args
+	r.dialect = defaultDialect
+
+	return r
+}
+
+// newInlineRenderer takes a renderer from the pool which writes the values as literals.
+//
+// Returns:
+//   - *renderer: The renderer with an empty buffer and the dialect of the package.
+func newInlineRenderer() *renderer {
+	r := newRenderer(nil)
+	r.inline = true
+
+	return r
+}
+
+// inlineString renders a node with its values as literals.
+//
+// Parameters:
+//   - node (renderable): The clause, condition or value.
+//
+// Returns:
+//   - string: The generated SQL.
+func inlineString(node renderable) string {
+	r := newInlineRenderer()
+	node.render(r)
+
+	sqlStr, _ := r.finish()
+
+	return sqlStr
+}
+
+// bindStringArgs renders a node with placeholders.
+//
+// Parameters:
+//   - node (renderable): The clause, condition or value.
+//   - args ([]any): The arguments of the enclosing statement, the new arguments are appended.
+//
+// Returns:
+//   - string: The generated SQL.
+//   - []any: The arguments.
+func bindStringArgs(node renderable, args []any) (string, []any) {
+	r := newRenderer(args)
+	node.render(r)
+
+	return r.finish()
+}
+
+// finish returns the generated SQL and arguments, and puts the renderer back in the pool.
+//
+// Returns:
+//   - string: The generated SQL.
+//   - []any: The arguments.
+func (r *renderer) finish() (string, []any) {
+	sqlStr, args := string(r.buf), r.args
+
+	r.release()
+
+	return sqlStr, args
+}
+
+// finishStatement returns the generated statement, and puts the renderer back in the pool.
+//
+// Returns:
+//   - string: The generated SQL.
+//   - []any: The arguments.
+//   - error: The first error of the render, nothing is returned then.
+func (r *renderer) finishStatement() (string, []any, error) {
+	if err := r.err; err != nil {
+		r.release()
+
+		return "", nil, err
+	}
+
+	sqlStr, args := r.finish()
+
+	return sqlStr, args, nil
+}
+
+// fail keeps the first error of the render, the statement is not returned by finishStatement.
+//
+// Parameters:
+//   - err (error): The error.
+func (r *renderer) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// release puts the renderer back in the pool without returning its result.
+func (r *renderer) release() {
+	r.args = nil
+	r.dialect = nil
+	r.inline = false
+	r.shape = false
+	r.err = nil
+
+	if cap(r.buf) > maxPooledBuffer {
+		return
+	}
+
+	r.buf = r.buf[:0]
+	rendererPool.Put(r)
+}
+
+// write appends a SQL fragment.
+//
+// Parameters:
+//   - s (string): The fragment.
+func (r *renderer) write(s string) {
+	r.buf = append(r.buf, s...)
+}
+
+// writeByte appends a single character.
+//
+// Parameters:
+//   - c (byte): The character.
+func (r *renderer) writeByte(c byte) {
+	r.buf = append(r.buf, c)
+}
+
+// writeAny appends a value formatted as %s, without the fmt package for strings and fmt.Stringer.
+//
+// Parameters:
+//   - value (any): The value, e.g. a field name or a FieldNot.
+func (r *renderer) writeAny(value any) {
+	switch v := value.(type) {
+	case string:
+		r.write(v)
+	case fmt.Stringer:
+		r.write(v.String())
+	default:
+		r.buf = fmt.Append(r.buf, value)
+	}
+}
+
+// writeList appends strings separated by a comma and a space.
+//
+// Parameters:
+//   - items ([]string): The strings.
+func (r *renderer) writeList(items []string) {
+	for i, item := range items {
+		if i > 0 {
+			r.write(", ")
+		}
+
+		r.write(item)
+	}
+}
+
+// bind appends an argument and writes its placeholder, or writes it as a literal in inline mode,
+// or writes ? in shape mode.
+//
+// Parameters:
+//   - value (any): The argument.
+func (r *renderer) bind(value any) {
+	if r.shape {
+		r.write(question)
+
+		return
+	}
+
+	if r.inline {
+		r.literal(value)
+
+		return
+	}
+
+	r.args = append(r.args, value)
+
+	if appender, ok := r.dialect.(placeholderAppender); ok {
+		r.buf = appender.appendPlaceholder(r.buf, len(r.args))
+
+		return
+	}
+
+	r.write(r.dialect.Placeholder(len(r.args)))
+}
+
+// literal writes a value as SQL: NULL for nil, a quoted string, :name for a Param,
+// or the value formatted with %v.
+//
+// Parameters:
+//   - value (any): The value.
+func (r *renderer) literal(value any) {
+	switch v := value.(type) {
+	case nil:
+		r.write("NULL")
+	case string:
+		r.writeByte('\'')
+		r.write(v)
+		r.writeByte('\'')
+	case Param:
+		r.write(v.String())
+	case int:
+		r.buf = strconv.AppendInt(r.buf, int64(v), 10)
+	case int64:
+		r.buf = strconv.AppendInt(r.buf, v, 10)
+	default:
+		r.buf = fmt.Append(r.buf, value)
+	}
+}
+
+// value writes a value: NULL for nil, the SQL of a ValueField, Expression, subquery or CASE,
+// and a placeholder for any other value, which is bound as an argument, or a literal in inline mode.
+//
+// Parameters:
+//   - value (any): The value.
+func (r *renderer) value(value any) {
+	switch v := value.(type) {
+	case nil:
+		r.write("NULL")
+	case ValueField:
+		r.write(v.String())
+	case Expression:
+		v.render(r)
+	case *QueryBuilder:
+		r.writeByte('(')
+		v.renderStatement(r)
+		r.writeByte(')')
+	case *Case:
+		v.render(r)
+	default:
+		r.bind(value)
+	}
+}
+
+// values writes the items of a slice or an array with value, separated by a comma and a space.
+//
+// Parameters:
+//   - values (any): The slice or the array. []any is written without reflection.
+func (r *renderer) values(values any) {
+	if items, ok := values.([]any); ok {
+		for i, item := range items {
+			if i > 0 {
+				r.write(", ")
+			}
+
+			r.value(item)
+		}
+
+		return
+	}
+
+	items := reflect.ValueOf(values)
+	for i := 0; i < items.Len(); i++ {
+		if i > 0 {
+			r.write(", ")
+		}
+
+		r.value(items.Index(i).Interface())
+	}
+}
+
+// appendPlaceholder appends the placeholder $n of PostgreSQL.
+//
+// Parameters:
+//   - buf ([]byte): The buffer.
+//   - position (int): The position of the placeholder (1-based).
+//
+// Returns:
+//   - []byte: The buffer with the placeholder.
+func (d PostgreSQLDialect) appendPlaceholder(buf []byte, position int) []byte {
+	buf = append(buf, dollar...)
+
+	return strconv.AppendInt(buf, int64(position), 10)
+}
@@ -0,0 +1,178 @@
+package fluentsql
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestRenderArgs
+func TestRenderArgs(t *testing.T) {
+	// The ON condition of a CROSS JOIN binds no argument
+	sql, args, _ := QueryInstance().
+		Select("*").
+		From("a").
+		Join(CrossJoin, "b", Condition{}).
+		Where("a.id", Eq, 1).
+		Sql()
+
+	expected := "SELECT * FROM a CROSS JOIN b WHERE a.id = $1"
+	if sql != expected || !reflect.DeepEqual(args, []any{1}) {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	// The arguments of the enclosing statement are kept
+	sql, args = Expr("price * ?", 3).StringArgs([]any{"a", "b"})
+	if sql != "price * $3" || !reflect.DeepEqual(args, []any{"a", "b", 3}) {
+		t.Fatalf(`Expression %s (%v)`, sql, args)
+	}
+}
+
+// TestRenderConcurrent
+func TestRenderConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func(id int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				sql, args, _ := compileQuery(id, "new").Sql()
+
+				expected, _, _ := compileQuery(0, "").Sql()
+				if sql != expected || args[0] != id {
+					t.Errorf(`Query %s != %s (%v)`, sql, expected, args)
+
+					return
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// BenchmarkInsertSql
+func BenchmarkInsertSql(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = InsertInstance().
+			Insert("users", "name", "email", "age").
+			Row("John", "john@example.com", i).
+			Row("Jane", "jane@example.com", i).
+			Sql()
+	}
+}
+
+// BenchmarkUpdateSql
+func BenchmarkUpdateSql(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = UpdateInstance().
+			Update("users").
+			Set("name", "John").
+			Set("updated_at", ValueField("NOW()")).
+			Where("id", Eq, i).
+			Where("status", In, []any{"new", "active"}).
+			Sql()
+	}
+}
+
+// BenchmarkDeleteSql
+func BenchmarkDeleteSql(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = DeleteInstance().
+			Delete("sessions").
+			Where("user_id", Eq, i).
+			Where("expires_at", Lesser, ValueField("NOW()")).
+			Sql()
+	}
+}
+
+// bindable is a clause generated both inline and with placeholders.
+type bindable interface {
+	String() string
+	StringArgs(args []any) (string, []any)
+}
+
+// inlineArgs replaces the placeholders of a statement with the literals of their arguments.
+func inlineArgs(sql string, args []any) string {
+	for i := len(args); i > 0; i-- {
+		r := newInlineRenderer()
+		r.literal(args[i-1])
+		literal, _ := r.finish()
+
+		sql = strings.ReplaceAll(sql, "$"+strconv.Itoa(i), literal)
+	}
+
+	return sql
+}
+
+// TestRenderModes
+func TestRenderModes(t *testing.T) {
+	subQuery := QueryInstance().Select("user_id").From("orders").Where("total", Greater, 100)
+
+	testCases := map[string]bindable{
+		"WHERE":       &Where{Conditions: []Condition{{Field: "id", Opt: Eq, Value: 1}, {Field: "name", Opt: Like, Value: "J%", AndOr: Or}}},
+		"condition":   &Condition{Field: "age", Opt: Between, Value: ValueBetween{Low: 18, High: 65}},
+		"in":          &Condition{Field: "id", Opt: In, Value: []int{1, 2, 3}},
+		"subquery":    &Condition{Field: "id", Opt: In, Value: subQuery},
+		"field":       &Condition{Field: "created_at", Opt: Greater, Value: ValueField("updated_at")},
+		"null":        &Condition{Field: "deleted_at", Opt: Eq, Value: nil},
+		"case":        &Condition{Field: "level", Opt: Eq, Value: FieldCase("", "").When("score > 10", "high")},
+		"year":        FieldYear("created_at"),
+		"param":       &Condition{Field: "email", Opt: Eq, Value: Param("email")},
+		"group":       &Condition{Group: []Condition{{Field: "a", Opt: Eq, Value: 1}, {Field: "b", Opt: Eq, Value: 2, AndOr: Or}}},
+		"HAVING":      &Having{Where{Conditions: []Condition{{Field: "COUNT(*)", Opt: Greater, Value: 5}}}},
+		"JOIN":        &Join{Items: []JoinItem{{Join: LeftJoin, Table: "orders o", Condition: Condition{Field: "o.status", Opt: Eq, Value: "paid"}}}},
+		"SELECT":      &Select{Columns: []any{"id", Expr("price * ?", 2), FieldCase("status", "label").When(1, "active")}},
+		"CASE":        FieldCase("", "size").When([]Condition{{Field: "qty", Opt: Greater, Value: 10}}, "large"),
+		"INSERT":      &InsertRows{Rows: []InsertRow{{Values: []any{"John", 30, nil}}}},
+		"UPDATE":      &UpdateSet{Items: []UpdateItem{{Field: "name", Value: "John"}, {Field: "visits", Value: Expr("visits + ?", 1)}}},
+		"expression":  Expr("total BETWEEN ? AND ?", 10, 20),
+		"named":       Named("created_at > :since", map[string]any{"since": "2024-01-01"}),
+		"query":       &From{Table: subQuery, Alias: "big"},
+		"DELETE":      &Delete{Table: "users"},
+		"empty WHERE": &Where{},
+	}
+
+	for name, clause := range testCases {
+		sql, args := clause.StringArgs(nil)
+
+		if inlined := inlineArgs(sql, args); inlined != clause.String() {
+			t.Fatalf(`Query %s: %s != %s`, name, inlined, clause.String())
+		}
+	}
+}
+
+// TestRenderModesBuilders
+func TestRenderModesBuilders(t *testing.T) {
+	testCases := map[string]interface {
+		String() string
+		Sql() (string, []any, error)
+	}{
+		"SELECT": QueryInstance().Select("id", "name").From("users").Where("age", GrEq, 18).Where("id", NotIn, []int{1, 2}).Having("COUNT(*)", Greater, 1).Limit(10, 20),
+		"INSERT": InsertInstance().Insert("users", "name", "age").Row("John", 30).Row("Jane", nil),
+		"UPDATE": UpdateInstance().Update("users").Set("name", "John").Where("id", Eq, 1),
+		"DELETE": DeleteInstance().Delete("users").Where("id", In, []int{1, 2}),
+	}
+
+	for name, builder := range testCases {
+		sql, args, err := builder.Sql()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if inlined := inlineArgs(sql, args); inlined != builder.String() {
+			t.Fatalf(`Query %s: %s != %s`, name, inlined, builder.String())
+		}
+	}
+}
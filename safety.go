@@ -0,0 +1,80 @@
+package fluentsql
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SafetyPolicy defines the package-level rules checked by UpdateBuilder.Sql and DeleteBuilder.Sql
+// before a statement which may affect many rows is generated.
+//
+// UPDATE and DELETE statements without a WHERE clause are always rejected unless AllRows is called.
+type SafetyPolicy struct {
+	// LimitTables lists the tables whose DELETE statements must have a LIMIT clause, so that purges
+	// of large tables run in batches. PostgreSQL and SQLite have no DELETE ... LIMIT: on them, a DELETE
+	// of these tables always fails with ErrMissingLimit unless AllRows is called.
+	LimitTables []string
+}
+
+// safetyPolicy is the current package-level safety policy.
+var safetyPolicy SafetyPolicy
+
+// SetSafetyPolicy sets the package-level safety policy.
+// Parameters:
+//   - policy (SafetyPolicy): The rules to enforce on UPDATE and DELETE statements.
+func SetSafetyPolicy(policy SafetyPolicy) {
+	safetyPolicy = policy
+}
+
+// checkWhere returns ErrMissingWhere for a statement without a WHERE clause.
+// The ON conditions of inner joined tables also restrict the affected rows, the outer joins do not.
+//
+// Parameters:
+//   - statement (string): The statement name used in the error message (UPDATE, DELETE).
+//   - table (any): The target table used in the error message.
+//   - where (Where): The WHERE clause of the statement.
+//   - join (Join): The JOIN clauses of the statement.
+//
+// Returns:
+//   - error: nil if the WHERE clause or an inner join has conditions.
+func checkWhere(statement string, table any, where Where, join Join) error {
+	for _, item := range join.Items {
+		if item.Join == InnerJoin && (item.Condition.Field != nil || len(item.Condition.Group) > 0) {
+			return nil
+		}
+	}
+
+	if len(where.Conditions) == 0 {
+		return fmt.Errorf("%w: %s %v, call AllRows() to allow it", ErrMissingWhere, statement, table)
+	}
+
+	return nil
+}
+
+// checkDeleteLimit returns ErrMissingLimit for a DELETE statement without a LIMIT clause
+// on a table listed in SafetyPolicy.LimitTables. The DELETE statements of PostgreSQL and SQLite
+// have no LIMIT clause, the tables listed cannot be deleted from in batches on them.
+//
+// Parameters:
+//   - table (any): The target table.
+//   - limit (Limit): The LIMIT clause of the statement.
+//
+// Returns:
+//   - error: nil if the table is not listed or the LIMIT clause is set on MySQL.
+func checkDeleteLimit(table any, limit Limit) error {
+	tableName, ok := table.(string)
+	if !ok || !slices.Contains(safetyPolicy.LimitTables, tableName) {
+		return nil
+	}
+
+	if !IsDialect(MySQL) {
+		return fmt.Errorf("%w: DELETE %s, DELETE ... LIMIT is unsupported by %s, call AllRows() to allow it",
+			ErrMissingLimit, tableName, DefaultDialect().Name())
+	}
+
+	if limit.Limit > 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: DELETE %s, call Limit() or AllRows() to allow it", ErrMissingLimit, tableName)
+}
@@ -0,0 +1,108 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestSafetyMissingWhere
+func TestSafetyMissingWhere(t *testing.T) {
+	if _, _, err := UpdateInstance().Update("products").Set("price", 1).Sql(); !errors.Is(err, ErrMissingWhere) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingWhere)
+	}
+
+	if _, _, err := DeleteInstance().Delete("products").Sql(); !errors.Is(err, ErrMissingWhere) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingWhere)
+	}
+
+	// An outer join does not restrict the affected rows
+	_, _, err := DeleteInstance().
+		Delete("orders", "o").
+		Using("customers c").
+		Join(LeftJoin, "archived_customers a", Condition{
+			Field: "a.customer_id",
+			Opt:   Eq,
+			Value: ValueField("c.id"),
+		}).
+		Sql()
+
+	if !errors.Is(err, ErrMissingWhere) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingWhere)
+	}
+
+	testCases := map[string]interface {
+		Sql() (string, []any, error)
+	}{
+		"UPDATE products SET price = $1": UpdateInstance().
+			Update("products").
+			Set("price", 1).
+			AllRows(),
+		"DELETE FROM products": DeleteInstance().
+			Delete("products").
+			AllRows(),
+		"DELETE FROM orders o USING archived_orders a WHERE a.order_id = o.id": DeleteInstance().
+			Delete("orders", "o").
+			Join(InnerJoin, "archived_orders a", Condition{
+				Field: "a.order_id",
+				Opt:   Eq,
+				Value: ValueField("o.id"),
+			}),
+	}
+
+	for expected, query := range testCases {
+		sql, args, err := query.Sql()
+
+		if err != nil || sql != expected {
+			t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+		}
+	}
+}
+
+// TestSafetyDeleteLimit
+func TestSafetyDeleteLimit(t *testing.T) {
+	SetDialect(new(MySQLDialect))
+	SetSafetyPolicy(SafetyPolicy{LimitTables: []string{"events"}})
+
+	defer SetDialect(new(PostgreSQLDialect))
+	defer SetSafetyPolicy(SafetyPolicy{})
+
+	_, _, err := DeleteInstance().
+		Delete("events").
+		Where("created_at", Lesser, "2024-01-01").
+		Sql()
+
+	if !errors.Is(err, ErrMissingLimit) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingLimit)
+	}
+
+	sql, args, err := DeleteInstance().
+		Delete("events").
+		Where("created_at", Lesser, "2024-01-01").
+		OrderBy("created_at", Asc).
+		Limit(1000).
+		Sql()
+
+	expected := "DELETE FROM events WHERE created_at < ? ORDER BY created_at ASC LIMIT ?"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+	}
+
+	if _, _, err = DeleteInstance().Delete("users").Where("id", Eq, 1).Sql(); err != nil {
+		t.Fatalf(`Error %v`, err)
+	}
+
+	// PostgreSQL has no DELETE ... LIMIT, the listed tables are rejected
+	SetDialect(new(PostgreSQLDialect))
+
+	if _, _, err = DeleteInstance().Delete("events").Where("created_at", Lesser, "2024-01-01").Sql(); !errors.Is(err, ErrMissingLimit) {
+		t.Fatalf(`Error %v != %v`, err, ErrMissingLimit)
+	}
+
+	if _, _, err = DeleteInstance().Delete("events").AllRows().Sql(); err != nil {
+		t.Fatalf(`Error %v`, err)
+	}
+
+	if _, _, err = DeleteInstance().Delete("users").Where("id", Eq, 1).Sql(); err != nil {
+		t.Fatalf(`Error %v`, err)
+	}
+}
@@ -0,0 +1,463 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// ScanMode defines how columns without a matching struct field are handled when scanning rows into structs.
+type ScanMode int
+
+const (
+	ScanStrict  ScanMode = iota // Return an error for a column without a matching field.
+	ScanLenient                 // Ignore the columns without a matching field.
+)
+
+// scanMode is the current package-level scan mode.
+var scanMode = ScanStrict
+
+// SetScanMode sets the package-level scan mode.
+// Parameters:
+//   - mode (ScanMode): ScanStrict (default) or ScanLenient.
+func SetScanMode(mode ScanMode) {
+	scanMode = mode
+}
+
+// Get runs the SELECT statement and scans the first row into dest.
+// The columns are mapped onto the `db` tags of a struct, a single column can also be scanned
+// into a scalar such as *int, *string or *time.Time.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx or *sql.Conn.
+//   - dest (any): A pointer to a struct or to a scalar.
+//
+// Returns:
+//   - error: sql.ErrNoRows wrapped in a QueryError if there is no row, or any generation, driver or scan error.
+func (qb *QueryBuilder) Get(ctx context.Context, runner Runner, dest any) error {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Pointer || destValue.IsNil() {
+		return fmt.Errorf("fluentsql: Get expects a non-nil pointer, got %T", dest)
+	}
+
+	sqlStr, args, err := qb.scopedSql(ctx, runner)
+
+	rows, err := queryContext(ctx, runner, qb.statement(sqlStr, args), err)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		if err = rows.Err(); err == nil {
+			err = sql.ErrNoRows
+		}
+
+		return wrapError(sqlStr, err)
+	}
+
+	scanner, err := newRowScanner(rows, destValue.Type().Elem())
+	if err != nil {
+		return wrapError(sqlStr, err)
+	}
+
+	if err = scanner.scan(rows, destValue.Elem()); err != nil {
+		return wrapError(sqlStr, err)
+	}
+
+	return wrapError(sqlStr, rows.Close())
+}
+
+// SelectInto runs the SELECT statement and appends all rows to the slice pointed at by dest.
+// The elements may be structs, pointers to structs or scalars, see Get for the column mapping.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx or *sql.Conn.
+//   - dest (any): A pointer to a slice, e.g. &[]User{}.
+//
+// Returns:
+//   - error: Any generation, driver or scan error.
+func (qb *QueryBuilder) SelectInto(ctx context.Context, runner Runner, dest any) error {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Pointer || destValue.IsNil() || destValue.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("fluentsql: SelectInto expects a pointer to a slice, got %T", dest)
+	}
+
+	sqlStr, args, err := qb.scopedSql(ctx, runner)
+
+	rows, err := queryContext(ctx, runner, qb.statement(sqlStr, args), err)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	sliceValue := destValue.Elem()
+
+	scanner, err := newRowScanner(rows, sliceValue.Type().Elem())
+	if err != nil {
+		return wrapError(sqlStr, err)
+	}
+
+	for rows.Next() {
+		element := reflect.New(sliceValue.Type().Elem()).Elem()
+
+		if err = scanner.scan(rows, element); err != nil {
+			return wrapError(sqlStr, err)
+		}
+
+		sliceValue.Set(reflect.Append(sliceValue, element))
+	}
+
+	if err = rows.Err(); err != nil {
+		return wrapError(sqlStr, err)
+	}
+
+	return wrapError(sqlStr, rows.Close())
+}
+
+// All runs the SELECT statement and returns all rows scanned into values of type T.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx or *sql.Conn.
+//   - qb (*QueryBuilder): The SELECT statement.
+//
+// Returns:
+//   - []T: The scanned rows.
+//   - error: Any generation, driver or scan error.
+//
+// Example:
+//
+//	users, err := fluentsql.All[User](ctx, db, fluentsql.QueryInstance().Select("id", "name").From("users"))
+func All[T any](ctx context.Context, runner Runner, qb *QueryBuilder) ([]T, error) {
+	var items []T
+
+	if err := qb.SelectInto(ctx, runner, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// One runs the SELECT statement and returns the first row scanned into a value of type T.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx or *sql.Conn.
+//   - qb (*QueryBuilder): The SELECT statement.
+//
+// Returns:
+//   - T: The scanned row.
+//   - error: sql.ErrNoRows wrapped in a QueryError if there is no row, or any generation, driver or scan error.
+func One[T any](ctx context.Context, runner Runner, qb *QueryBuilder) (T, error) {
+	var item T
+
+	err := qb.Get(ctx, runner, &item)
+
+	return item, err
+}
+
+// Rows runs the SELECT statement and returns an iterator which scans the rows lazily into values of type T.
+// The rows are closed when the loop ends or breaks. A generation, driver or scan error is yielded
+// with the zero value of T and ends the iteration.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): A *sql.DB, *sql.Tx or *sql.Conn.
+//   - qb (*QueryBuilder): The SELECT statement.
+//
+// Returns:
+//   - iter.Seq2[T, error]: The iterator, the statement runs when the loop starts.
+//
+// Example:
+//
+//	for user, err := range fluentsql.Rows[User](ctx, db, query) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    ...
+//	}
+func Rows[T any](ctx context.Context, runner Runner, qb *QueryBuilder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		sqlStr, args, err := qb.scopedSql(ctx, runner)
+
+		rows, err := queryContext(ctx, runner, qb.statement(sqlStr, args), err)
+		if err != nil {
+			yield(zero, err)
+
+			return
+		}
+
+		defer func() { _ = rows.Close() }()
+
+		scanner, err := newRowScanner(rows, reflect.TypeOf((*T)(nil)).Elem())
+		if err != nil {
+			yield(zero, wrapError(sqlStr, err))
+
+			return
+		}
+
+		for rows.Next() {
+			var item T
+
+			if err = scanner.scan(rows, reflect.ValueOf(&item).Elem()); err != nil {
+				yield(zero, wrapError(sqlStr, err))
+
+				return
+			}
+
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		if err = rows.Err(); err != nil {
+			yield(zero, wrapError(sqlStr, err))
+		}
+	}
+}
+
+// rowScanner scans the rows of a result into values of one type.
+type rowScanner struct {
+	// typ is the type of the scanned values, a struct, a pointer to a struct or a scalar.
+	typ reflect.Type
+	// isStruct reports whether the columns are mapped onto struct fields.
+	isStruct bool
+	// columns are the column names of the result.
+	columns []string
+	// indexes holds the field index sequence of each column, nil for an ignored column.
+	indexes [][]int
+	// deferred reports whether a column is mapped behind a struct pointer, which is allocated after the scan
+	// for a non-NULL value only.
+	deferred []bool
+}
+
+// newRowScanner maps the columns of a result onto a type.
+//
+// Parameters:
+//   - rows (*sql.Rows): The result.
+//   - typ (reflect.Type): The type of the scanned values.
+//
+// Returns:
+//   - *rowScanner: The scanner.
+//   - error: An error for a scalar with several columns, or an unknown column in ScanStrict mode.
+func newRowScanner(rows *sql.Rows, typ reflect.Type) (*rowScanner, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	return newColumnScanner(columns, typ)
+}
+
+// newColumnScanner maps a list of columns onto a type.
+//
+// Parameters:
+//   - columns ([]string): The column names.
+//   - typ (reflect.Type): The type of the scanned values.
+//
+// Returns:
+//   - *rowScanner: The scanner.
+//   - error: An error for a scalar with several columns, or an unknown column in ScanStrict mode.
+func newColumnScanner(columns []string, typ reflect.Type) (*rowScanner, error) {
+	structType := typ
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	scanner := &rowScanner{
+		typ:      typ,
+		columns:  columns,
+		isStruct: structType.Kind() == reflect.Struct && !isValueType(structType),
+	}
+
+	if !scanner.isStruct {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("fluentsql: cannot scan %d columns into %s", len(columns), typ)
+		}
+
+		return scanner, nil
+	}
+
+	info := structInfoOf(structType)
+
+	for _, column := range columns {
+		position, ok := info.byName[column]
+		if !ok {
+			if scanMode == ScanStrict {
+				return nil, fmt.Errorf("fluentsql: missing destination for column %q in %s", column, structType)
+			}
+
+			scanner.indexes = append(scanner.indexes, nil)
+			scanner.deferred = append(scanner.deferred, false)
+
+			continue
+		}
+
+		index := info.fields[position].Index
+
+		scanner.indexes = append(scanner.indexes, index)
+		scanner.deferred = append(scanner.deferred, behindPointer(structType, index))
+	}
+
+	return scanner, nil
+}
+
+// scan scans the current row into dest.
+//
+// The columns mapped behind a struct pointer are scanned into intermediates: the pointer is allocated
+// when one of its columns is not NULL, and stays nil for the unmatched rows of a LEFT JOIN.
+//
+// Parameters:
+//   - rows (*sql.Rows): The result positioned on a row.
+//   - dest (reflect.Value): The settable destination value.
+//   - extra (...any): The destinations of the columns following the mapped columns.
+//
+// Returns:
+//   - error: Any scan error.
+func (s *rowScanner) scan(rows *sql.Rows, dest reflect.Value, extra ...any) error {
+	if !s.isStruct {
+		return rows.Scan(append([]any{dest.Addr().Interface()}, extra...)...)
+	}
+
+	if dest.Kind() == reflect.Pointer {
+		if dest.IsNil() {
+			dest.Set(reflect.New(dest.Type().Elem()))
+		}
+
+		dest = dest.Elem()
+	}
+
+	targets := make([]any, len(s.indexes))
+
+	for i, index := range s.indexes {
+		if index == nil || s.deferred[i] {
+			targets[i] = new(any)
+
+			continue
+		}
+
+		targets[i] = fieldValueAlloc(dest, index).Addr().Interface()
+	}
+
+	if err := rows.Scan(append(targets, extra...)...); err != nil {
+		return err
+	}
+
+	for i, index := range s.indexes {
+		if index == nil || !s.deferred[i] {
+			continue
+		}
+
+		src := *targets[i].(*any)
+		if src == nil {
+			continue
+		}
+
+		if err := assignValue(fieldValueAlloc(dest, index), src); err != nil {
+			return fmt.Errorf("fluentsql: scan error on column %q: %w", s.columns[i], err)
+		}
+	}
+
+	return nil
+}
+
+// assignValue converts a non-NULL column value and stores it into a field, the way database/sql scans it.
+//
+// Parameters:
+//   - field (reflect.Value): The addressable field.
+//   - src (any): The column value returned by the driver.
+//
+// Returns:
+//   - error: An error if the value cannot be converted into the type of the field.
+func assignValue(field reflect.Value, src any) error {
+	if scanner, ok := field.Addr().Interface().(sql.Scanner); ok {
+		return scanner.Scan(src)
+	}
+
+	if field.Kind() == reflect.Pointer {
+		value := reflect.New(field.Type().Elem())
+		if err := assignValue(value.Elem(), src); err != nil {
+			return err
+		}
+
+		field.Set(value)
+
+		return nil
+	}
+
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Type().AssignableTo(field.Type()) {
+		field.Set(srcValue)
+
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		var value sql.NullString
+		if err := value.Scan(src); err != nil {
+			return err
+		}
+
+		field.SetString(value.String)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var value sql.NullInt64
+		if err := value.Scan(src); err != nil {
+			return err
+		}
+
+		if field.OverflowInt(value.Int64) {
+			return fmt.Errorf("value %d overflows %s", value.Int64, field.Type())
+		}
+
+		field.SetInt(value.Int64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var value sql.NullString
+		if err := value.Scan(src); err != nil {
+			return err
+		}
+
+		number, err := strconv.ParseUint(value.String, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetUint(number)
+	case reflect.Float32, reflect.Float64:
+		var value sql.NullFloat64
+		if err := value.Scan(src); err != nil {
+			return err
+		}
+
+		if field.OverflowFloat(value.Float64) {
+			return fmt.Errorf("value %v overflows %s", value.Float64, field.Type())
+		}
+
+		field.SetFloat(value.Float64)
+	case reflect.Bool:
+		var value sql.NullBool
+		if err := value.Scan(src); err != nil {
+			return err
+		}
+
+		field.SetBool(value.Bool)
+	default:
+		if !srcValue.Type().ConvertibleTo(field.Type()) {
+			return fmt.Errorf("unsupported conversion of %T into %s", src, field.Type())
+		}
+
+		field.Set(srcValue.Convert(field.Type()))
+	}
+
+	return nil
+}
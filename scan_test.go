@@ -0,0 +1,217 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testTimestamps struct {
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type testCompany struct {
+	ID   int64  `db:"id"`
+	Name string `db:"name"`
+}
+
+type testUser struct {
+	testTimestamps
+	ID      int64          `db:"id"`
+	Name    string         `db:"name"`
+	Email   sql.NullString `db:"email"`
+	Age     *int64         `db:"age"`
+	Company *testCompany   `db:"company"`
+}
+
+// TestScanGet
+func TestScanGet(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	db, _ := newFakeDB(t, func(_ string, _ []any) fakeResult {
+		return fakeResult{
+			Columns: []string{"id", "name", "email", "age", "created_at", "company.id", "company.name"},
+			Rows:    [][]any{{int64(1), "John", nil, int64(42), created, int64(9), "Acme"}},
+		}
+	})
+
+	var user testUser
+
+	err := QueryInstance().
+		Select("u.id", "u.name", "u.email", "u.age", "u.created_at", `c.id AS "company.id"`, `c.name AS "company.name"`).
+		From("users", "u").
+		Get(context.Background(), db, &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.ID != 1 || user.Name != "John" || user.Email.Valid || user.Age == nil || *user.Age != 42 ||
+		!user.CreatedAt.Equal(created) || user.Company == nil || user.Company.Name != "Acme" {
+		t.Fatalf(`User %+v`, user)
+	}
+
+	var count int
+	if err = QueryInstance().Select("COUNT(*)").From("users").Get(context.Background(), db, &count); err == nil {
+		t.Fatalf(`Scan of 7 columns into int must fail`)
+	}
+}
+
+// TestScanLeftJoin
+func TestScanLeftJoin(t *testing.T) {
+	db, _ := newFakeDB(t, func(_ string, _ []any) fakeResult {
+		return fakeResult{
+			Columns: []string{"id", "name", "company.id", "company.name"},
+			Rows: [][]any{
+				{int64(1), "John", int64(9), []byte("Acme")},
+				{int64(2), "Jane", nil, nil},
+			},
+		}
+	})
+
+	users, err := All[testUser](context.Background(), db, QueryInstance().
+		Select("u.id", "u.name", `c.id AS "company.id"`, `c.name AS "company.name"`).
+		From("users", "u").
+		Join(LeftJoin, "companies c", Condition{Field: "c.id", Opt: Eq, Value: ValueField("u.company_id")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 2 || users[0].Company == nil || users[0].Company.ID != 9 || users[0].Company.Name != "Acme" {
+		t.Fatalf(`Users %+v`, users)
+	}
+
+	if users[1].Company != nil {
+		t.Fatalf(`Company of an unmatched row must be nil, got %+v`, users[1].Company)
+	}
+}
+
+// TestScanSelectInto
+func TestScanSelectInto(t *testing.T) {
+	db, _ := newFakeDB(t, func(_ string, _ []any) fakeResult {
+		return fakeResult{
+			Columns: []string{"id", "name", "age"},
+			Rows:    [][]any{{int64(1), "John", nil}, {int64(2), "Jane", int64(30)}},
+		}
+	})
+
+	ctx := context.Background()
+	query := QueryInstance().Select("id", "name", "age").From("users")
+
+	var users []*testUser
+	if err := query.SelectInto(ctx, db, &users); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 2 || users[0].Age != nil || users[1].Name != "Jane" || *users[1].Age != 30 {
+		t.Fatalf(`Users %+v`, users)
+	}
+
+	all, err := All[testUser](ctx, db, query)
+	if err != nil || len(all) != 2 || all[1].ID != 2 {
+		t.Fatalf(`Users %+v (%v)`, all, err)
+	}
+
+	one, err := One[testUser](ctx, db, query)
+	if err != nil || one.Name != "John" {
+		t.Fatalf(`User %+v (%v)`, one, err)
+	}
+}
+
+// TestScanModes
+func TestScanModes(t *testing.T) {
+	db, _ := newFakeDB(t, func(query string, _ []any) fakeResult {
+		if query == "SELECT id FROM users WHERE id = $1" {
+			return fakeResult{Columns: []string{"id"}}
+		}
+
+		return fakeResult{
+			Columns: []string{"id", "unknown"},
+			Rows:    [][]any{{int64(1), "x"}},
+		}
+	})
+
+	ctx := context.Background()
+	query := QueryInstance().Select("id", "unknown").From("users")
+
+	if _, err := One[testUser](ctx, db, query); err == nil {
+		t.Fatalf(`Strict mode must reject unknown columns`)
+	}
+
+	SetScanMode(ScanLenient)
+	defer SetScanMode(ScanStrict)
+
+	user, err := One[testUser](ctx, db, query)
+	if err != nil || user.ID != 1 {
+		t.Fatalf(`User %+v (%v)`, user, err)
+	}
+
+	ids, err := All[int64](ctx, db, QueryInstance().Select("id").From("users").Where("id", Eq, 1))
+	if err != nil || len(ids) != 0 {
+		t.Fatalf(`Ids %v (%v)`, ids, err)
+	}
+
+	_, err = One[int64](ctx, db, QueryInstance().Select("id").From("users").Where("id", Eq, 1))
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf(`Error %v != %v`, err, sql.ErrNoRows)
+	}
+}
+
+// TestScanRows
+func TestScanRows(t *testing.T) {
+	db, fake := newFakeDB(t, func(_ string, _ []any) fakeResult {
+		return fakeResult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]any{{int64(1), "John"}, {int64(2), "Jane"}, {int64(3), "Jack"}},
+		}
+	})
+
+	ctx := context.Background()
+	query := QueryInstance().Select("id", "name").From("users")
+
+	var names []string
+
+	for user, err := range Rows[testUser](ctx, db, query) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names = append(names, user.Name)
+	}
+
+	if len(names) != 3 || names[2] != "Jack" {
+		t.Fatalf(`Names %v`, names)
+	}
+
+	for user, err := range Rows[*testUser](ctx, db, query) {
+		if err != nil || user.ID != 1 {
+			t.Fatalf(`User %+v (%v)`, user, err)
+		}
+
+		break
+	}
+
+	fake.mu.Lock()
+	closed := fake.closed
+	fake.mu.Unlock()
+
+	if closed != 2 {
+		t.Fatalf(`Closed rows %d != 2`, closed)
+	}
+
+	var errs int
+
+	for _, err := range Rows[int64](ctx, db, query) {
+		var queryErr *QueryError
+		if !errors.As(err, &queryErr) {
+			t.Fatalf(`Error %v is not a QueryError`, err)
+		}
+
+		errs++
+	}
+
+	if errs != 1 {
+		t.Fatalf(`Errors %d != 1`, errs)
+	}
+}
@@ -0,0 +1,580 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ====================================================================
+//                   Scope :: Structure
+// ====================================================================
+
+// ScopeRule requires a column value on every row of a table read or written through a scope.
+type ScopeRule struct {
+	// Table is the scoped table, compared with the table names of the builders.
+	Table string
+	// Column is the required column, qualified with the alias or the name of the table in the conditions.
+	Column string
+	// Value is the required value of the column, e.g. the tenant id. A Param keeps it as a slot.
+	Value any
+}
+
+// Scope is a set of rules applied to the builders run through an executor or a context:
+//   - SELECT: the tables of FROM get a WHERE condition, the joined tables an ON condition
+//   - UPDATE and DELETE: the target, FROM and USING tables get a WHERE condition, the joined tables an ON condition
+//   - INSERT: the column is added to the rows, or checked if it is given
+//
+// The rules apply to the subqueries too. A Scope must not be modified once in use.
+type Scope struct {
+	rules map[string][]ScopeRule // rules are the rules by table.
+}
+
+// scopeKey is the context key of the scopes.
+type scopeKey struct{}
+
+// tableFilter returns the conditions required on a table.
+//
+// Parameters:
+//   - table (string): The name of the table.
+//   - ref (string): The alias of the table, its name if it has none.
+//
+// Returns:
+//   - []Condition: The conditions, on columns qualified with ref.
+type tableFilter func(table, ref string) []Condition
+
+// ====================================================================
+//                   Scope :: Operators
+// ====================================================================
+
+// NewScope creates a scope from rules.
+//
+// Parameters:
+//   - rules (...ScopeRule): The rules, several rules may apply to one table.
+//
+// Returns:
+//   - *Scope: The scope, to be given to WithScope.
+//
+// Example:
+//
+//	scope := fluentsql.NewScope(
+//	    fluentsql.ScopeRule{Table: "orders", Column: "tenant_id", Value: tenantID},
+//	    fluentsql.ScopeRule{Table: "invoices", Column: "tenant_id", Value: tenantID},
+//	)
+func NewScope(rules ...ScopeRule) *Scope {
+	scope := &Scope{rules: make(map[string][]ScopeRule)}
+
+	for _, rule := range rules {
+		scope.rules[rule.Table] = append(scope.rules[rule.Table], rule)
+	}
+
+	return scope
+}
+
+// WithScope returns a copy of the context whose builders are run with the rules of the scope,
+// in addition to the scopes of the executor.
+//
+// Parameters:
+//   - ctx (context.Context): The parent context.
+//   - scope (*Scope): The scope, e.g. of the tenant of a request.
+//
+// Returns:
+//   - context.Context: The context with the scope.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	scopes, _ := ctx.Value(scopeKey{}).([]*Scope)
+
+	return context.WithValue(ctx, scopeKey{}, append(slices.Clip(scopes), scope))
+}
+
+// WithScope returns a copy of the executor whose builders are run with the rules of the scope.
+//
+// Parameters:
+//   - scope (*Scope): The scope.
+//
+// Returns:
+//   - *Executor: The executor with the scope.
+func (e *Executor) WithScope(scope *Scope) *Executor {
+	return &Executor{
+		runner: e.runner,
+		hooks:  e.hooks,
+		cache:  e.cache,
+		scopes: append(slices.Clip(e.scopes), scope),
+	}
+}
+
+// Apply adds the rules of the scope to a statement in place, e.g. before Compile, which applies no scope.
+// The builders marked with Unscoped and their subqueries are left unchanged.
+//
+// Parameters:
+//   - node (Node): The builder.
+//
+// Returns:
+//   - error: ErrScopeViolation if an INSERT or UPDATE statement writes rows out of the scope.
+func (s *Scope) Apply(node Node) error {
+	var err error
+
+	Walk(node, func(n Node) bool {
+		switch b := n.(type) {
+		case *QueryBuilder:
+			if b.unscoped {
+				return false
+			}
+
+			filterTables(s.filter, &b.whereStatement, &b.joinStatement, b.fromStatement)
+		case *UpdateBuilder:
+			if b.unscoped {
+				return false
+			}
+
+			if checkErr := s.check(b); err == nil {
+				err = checkErr
+			}
+
+			from := From{Table: b.updateStatement.Table, Alias: b.updateStatement.Alias}
+			filterTables(s.filter, &b.whereStatement, &b.joinStatement, from, b.fromStatement)
+		case *DeleteBuilder:
+			if b.unscoped {
+				return false
+			}
+
+			from := []From{{Table: b.deleteStatement.Table, Alias: b.deleteStatement.Alias}}
+			for _, table := range b.usingStatement {
+				from = append(from, From{Table: table})
+			}
+
+			filterTables(s.filter, &b.whereStatement, &b.joinStatement, from...)
+		case *InsertBuilder:
+			if b.unscoped {
+				return false
+			}
+
+			if fillErr := s.fill(b); err == nil {
+				err = fillErr
+			}
+		}
+
+		return true
+	})
+
+	return err
+}
+
+// filter returns the conditions of the rules of a table.
+//
+// Parameters:
+//   - table (string): The name of the table.
+//   - ref (string): The alias of the table, its name if it has none.
+//
+// Returns:
+//   - []Condition: The conditions ref.column = value.
+func (s *Scope) filter(table, ref string) []Condition {
+	var conditions []Condition
+
+	for _, rule := range s.rules[table] {
+		conditions = append(conditions, Condition{Field: ref + "." + rule.Column, Opt: Eq, Value: rule.Value})
+	}
+
+	return conditions
+}
+
+// fill adds the columns of the rules to the rows of an INSERT statement. A column given by the rows
+// must hold the value of the rule.
+//
+// Parameters:
+//   - ib (*InsertBuilder): The INSERT statement, modified in place.
+//
+// Returns:
+//   - error: ErrScopeViolation if the column cannot be added or holds another value.
+func (s *Scope) fill(ib *InsertBuilder) error {
+	table := ib.insertStatement.Table
+
+	for _, rule := range s.rules[table] {
+		index := slices.Index(ib.insertStatement.Columns, rule.Column)
+
+		if index < 0 {
+			if len(ib.insertStatement.Columns) == 0 || ib.queryStatement.Query != nil {
+				return fmt.Errorf("%w: INSERT INTO %s without column %s", ErrScopeViolation, table, rule.Column)
+			}
+
+			ib.insertStatement.Columns = append(ib.insertStatement.Columns, rule.Column)
+
+			for i := range ib.rowStatement.Rows {
+				ib.rowStatement.Rows[i].Values = append(ib.rowStatement.Rows[i].Values, rule.Value)
+			}
+
+			continue
+		}
+
+		for _, row := range ib.rowStatement.Rows {
+			if index < len(row.Values) && !sameValue(row.Values[index], rule.Value) {
+				return fmt.Errorf("%w: INSERT INTO %s with %s = %v", ErrScopeViolation, table, rule.Column, row.Values[index])
+			}
+		}
+	}
+
+	return nil
+}
+
+// check reports the assignments of an UPDATE statement which move the rows out of the scope.
+//
+// Parameters:
+//   - ub (*UpdateBuilder): The UPDATE statement.
+//
+// Returns:
+//   - error: ErrScopeViolation if a column of the rules is set to another value.
+func (s *Scope) check(ub *UpdateBuilder) error {
+	table, ok := ub.updateStatement.Table.(string)
+	if !ok {
+		return nil
+	}
+
+	name, ref := tableRef(table, ub.updateStatement.Alias)
+
+	for _, rule := range s.rules[name] {
+		for _, item := range ub.setStatement.Items {
+			columns, values := assignments(item)
+
+			for i, column := range columns {
+				if column != rule.Column && column != ref+"."+rule.Column {
+					continue
+				}
+
+				// The value of a column set by a subquery is not known
+				if i >= len(values) {
+					return fmt.Errorf("%w: UPDATE %s SET %s from a subquery", ErrScopeViolation, table, rule.Column)
+				}
+
+				if !sameValue(values[i], rule.Value) {
+					return fmt.Errorf("%w: UPDATE %s SET %s = %v", ErrScopeViolation, table, rule.Column, values[i])
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// assignments lists the columns of an assignment of the SET clause with their values,
+// e.g. SET (a, b) = (1, 2) assigns 1 to a and 2 to b.
+//
+// Parameters:
+//   - item (UpdateItem): The assignment.
+//
+// Returns:
+//   - []string: The assigned columns.
+//   - []any: The values of the columns, nil when they are set by a subquery.
+func assignments(item UpdateItem) ([]string, []any) {
+	columns, ok := item.Field.([]string)
+	if !ok {
+		column, _ := item.Field.(string)
+
+		return []string{column}, []any{item.Value}
+	}
+
+	values, _ := item.Value.([]any)
+
+	return columns, values
+}
+
+// sameValue reports whether two values are stored the same in a column, e.g. int(1) and int64(1).
+// Both are converted as database/sql converts the arguments of a statement before the comparison.
+//
+// Parameters:
+//   - a (any): A value.
+//   - b (any): Another value.
+//
+// Returns:
+//   - bool: true if the converted values are equal.
+func sameValue(a, b any) bool {
+	convertedA, errA := driver.DefaultParameterConverter.ConvertValue(a)
+	convertedB, errB := driver.DefaultParameterConverter.ConvertValue(b)
+
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+
+	return reflect.DeepEqual(convertedA, convertedB)
+}
+
+// filterTables adds the conditions required on the tables of a statement. The conditions of the FROM
+// and CROSS JOIN tables are added to the WHERE clause, those of the other joined tables to their
+// ON condition, so that an outer join keeps its rows.
+//
+// Parameters:
+//   - filter (tableFilter): The conditions required on a table.
+//   - where (*Where): The WHERE clause of the statement.
+//   - join (*Join): The JOIN clauses of the statement.
+//   - from (...From): The tables of the statement other than the joined ones, nested queries are skipped.
+func filterTables(filter tableFilter, where *Where, join *Join, from ...From) {
+	var conditions []Condition
+
+	for _, item := range from {
+		if table, ok := item.Table.(string); ok && table != "" {
+			name, ref := tableRef(table, item.Alias)
+			conditions = append(conditions, filter(name, ref)...)
+		}
+	}
+
+	for i := range join.Items {
+		item := &join.Items[i]
+
+		name, ref := tableRef(item.Table, "")
+
+		required := filter(name, ref)
+		if len(required) == 0 {
+			continue
+		}
+
+		if item.Join == CrossJoin {
+			conditions = append(conditions, required...)
+
+			continue
+		}
+
+		if item.Condition.Field == nil && len(item.Condition.Group) == 0 {
+			item.Condition = Condition{Group: required}
+		} else {
+			item.Condition = Condition{Group: append([]Condition{item.Condition}, required...)}
+		}
+	}
+
+	requireConditions(where, conditions...)
+}
+
+// requireConditions adds conditions to a WHERE clause. The existing conditions are grouped first
+// if they contain OR, so that the added conditions restrict all of them.
+//
+// Parameters:
+//   - where (*Where): The WHERE clause.
+//   - conditions (...Condition): The required conditions.
+func requireConditions(where *Where, conditions ...Condition) {
+	if len(conditions) == 0 {
+		return
+	}
+
+	where.Conditions = grouped(where.Conditions)
+	where.Append(conditions...)
+}
+
+// tableRef splits a table reference such as "orders o" or "orders AS o".
+//
+// Parameters:
+//   - table (string): The table reference.
+//   - alias (string): The alias given apart, e.g. From("orders", "o").
+//
+// Returns:
+//   - string: The name of the table.
+//   - string: The alias of the table, its name if it has none.
+func tableRef(table, alias string) (string, string) {
+	fields := strings.Fields(table)
+	if len(fields) == 0 {
+		return "", ""
+	}
+
+	name := fields[0]
+
+	switch {
+	case alias != "":
+		return name, alias
+	case len(fields) > 1:
+		return name, fields[len(fields)-1]
+	}
+
+	return name, name
+}
+
+// scopesOf returns the scopes of a statement: those of the context, then those of the executors.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The runner of the statement.
+//
+// Returns:
+//   - []*Scope: The scopes, nil if there is none.
+func scopesOf(ctx context.Context, runner Runner) []*Scope {
+	scopes, _ := ctx.Value(scopeKey{}).([]*Scope)
+
+	for {
+		executor, ok := runner.(*Executor)
+		if !ok {
+			break
+		}
+
+		scopes = append(slices.Clip(scopes), executor.scopes...)
+		runner = executor.runner
+	}
+
+	return scopes
+}
+
+// Unscoped runs the SELECT statement without the scopes of the executor and context. The statement
+// is reported to the hooks with Statement.Unscoped set, so that the bypasses can be audited.
+//
+// Returns:
+//   - *QueryBuilder: The QueryBuilder instance.
+func (qb *QueryBuilder) Unscoped() *QueryBuilder {
+	qb.unscoped = true
+
+	return qb
+}
+
+// Unscoped runs the INSERT statement without the scopes of the executor and context, see QueryBuilder.Unscoped.
+//
+// Returns:
+//   - *InsertBuilder: The InsertBuilder instance.
+func (ib *InsertBuilder) Unscoped() *InsertBuilder {
+	ib.unscoped = true
+
+	return ib
+}
+
+// Unscoped runs the UPDATE statement without the scopes of the executor and context, see QueryBuilder.Unscoped.
+//
+// Returns:
+//   - *UpdateBuilder: The UpdateBuilder instance.
+func (ub *UpdateBuilder) Unscoped() *UpdateBuilder {
+	ub.unscoped = true
+
+	return ub
+}
+
+// Unscoped runs the DELETE statement without the scopes of the executor and context, see QueryBuilder.Unscoped.
+//
+// Returns:
+//   - *DeleteBuilder: The DeleteBuilder instance.
+func (db *DeleteBuilder) Unscoped() *DeleteBuilder {
+	db.unscoped = true
+
+	return db
+}
+
+// scopedSql generates the SELECT statement with the scopes of the context and executor.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The runner of the statement.
+//
+// Returns:
+//   - string: The generated statement.
+//   - []any: The arguments.
+//   - error: The error of the statement generation.
+func (qb *QueryBuilder) scopedSql(ctx context.Context, runner Runner) (string, []any, error) {
+	scopes := scopesOf(ctx, runner)
+	if len(scopes) == 0 || qb.unscoped {
+		return qb.Sql()
+	}
+
+	query := qb.Clone()
+	if err := applyScopes(query, scopes); err != nil {
+		return "", nil, err
+	}
+
+	return query.Sql()
+}
+
+// scopedSql generates the INSERT statement with the scopes of the context and executor.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The runner of the statement.
+//
+// Returns:
+//   - string: The generated statement.
+//   - []any: The arguments.
+//   - error: The error of the statement generation, e.g. ErrScopeViolation.
+func (ib *InsertBuilder) scopedSql(ctx context.Context, runner Runner) (string, []any, error) {
+	scopes := scopesOf(ctx, runner)
+	if len(scopes) == 0 || ib.unscoped {
+		return ib.Sql()
+	}
+
+	insert := ib.Clone()
+	if err := applyScopes(insert, scopes); err != nil {
+		return "", nil, err
+	}
+
+	return insert.Sql()
+}
+
+// scopedSql generates the UPDATE statement with the scopes of the context and executor.
+// The guards are checked before the scope conditions are added.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The runner of the statement.
+//
+// Returns:
+//   - string: The generated statement.
+//   - []any: The arguments.
+//   - error: The error of the statement generation, e.g. ErrMissingWhere.
+func (ub *UpdateBuilder) scopedSql(ctx context.Context, runner Runner) (string, []any, error) {
+	scopes := scopesOf(ctx, runner)
+	if len(scopes) == 0 || ub.unscoped {
+		return ub.Sql()
+	}
+
+	if err := ub.check(); err != nil {
+		return "", nil, err
+	}
+
+	update := ub.Clone()
+	update.allRows = true
+
+	if err := applyScopes(update, scopes); err != nil {
+		return "", nil, err
+	}
+
+	return update.Sql()
+}
+
+// scopedSql generates the DELETE statement with the scopes of the context and executor.
+// The guards are checked before the scope conditions are added.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - runner (Runner): The runner of the statement.
+//
+// Returns:
+//   - string: The generated statement.
+//   - []any: The arguments.
+//   - error: The error of the statement generation, e.g. ErrMissingWhere.
+func (db *DeleteBuilder) scopedSql(ctx context.Context, runner Runner) (string, []any, error) {
+	scopes := scopesOf(ctx, runner)
+	if len(scopes) == 0 || db.unscoped {
+		return db.Sql()
+	}
+
+	if err := db.check(); err != nil {
+		return "", nil, err
+	}
+
+	del := db.Clone()
+	del.allRows = true
+
+	if err := applyScopes(del, scopes); err != nil {
+		return "", nil, err
+	}
+
+	return del.Sql()
+}
+
+// applyScopes applies scopes to a statement in place.
+//
+// Parameters:
+//   - node (Node): The builder.
+//   - scopes ([]*Scope): The scopes.
+//
+// Returns:
+//   - error: The first error of Apply.
+func applyScopes(node Node, scopes []*Scope) error {
+	for _, scope := range scopes {
+		if err := scope.Apply(node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
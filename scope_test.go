@@ -0,0 +1,178 @@
+package fluentsql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// tenantScope scopes the orders and order_items tables to the tenant 7.
+var tenantScope = NewScope(
+	ScopeRule{Table: "orders", Column: "tenant_id", Value: 7},
+	ScopeRule{Table: "order_items", Column: "tenant_id", Value: 7},
+)
+
+// TestScopeApply
+func TestScopeApply(t *testing.T) {
+	testCases := map[string]Node{
+		"SELECT * FROM orders WHERE status = 'paid' AND orders.tenant_id = 7": QueryInstance().
+			Select("*").
+			From("orders").
+			Where("status", Eq, "paid"),
+		"SELECT * FROM orders o LEFT JOIN order_items i ON (i.order_id = o.id AND i.tenant_id = 7) WHERE o.tenant_id = 7": QueryInstance().
+			Select("*").
+			From("orders", "o").
+			Join(LeftJoin, "order_items i", Condition{Field: "i.order_id", Opt: Eq, Value: ValueField("o.id")}),
+		"SELECT * FROM orders WHERE (status = 'new' OR status = 'paid') AND orders.tenant_id = 7": QueryInstance().
+			Select("*").
+			From("orders").
+			Where("status", Eq, "new").
+			WhereOr("status", Eq, "paid"),
+		"SELECT * FROM users WHERE id IN (SELECT user_id FROM orders WHERE orders.tenant_id = 7)": QueryInstance().
+			Select("*").
+			From("users").
+			Where("id", In, QueryInstance().Select("user_id").From("orders")),
+		"SELECT * FROM users WHERE id IN (SELECT user_id FROM orders)": QueryInstance().
+			Select("*").
+			From("users").
+			Where("id", In, QueryInstance().Select("user_id").From("orders").Unscoped()),
+		"UPDATE orders SET status = 'paid' WHERE id = 1 AND orders.tenant_id = 7": UpdateInstance().
+			Update("orders").
+			Set("status", "paid").
+			Where("id", Eq, 1),
+		"UPDATE orders SET (status, tenant_id) = ('paid', 7) WHERE id = 1 AND orders.tenant_id = 7": UpdateInstance().
+			Update("orders").
+			Set([]string{"status", "tenant_id"}, []any{"paid", 7}).
+			Where("id", Eq, 1),
+		"DELETE FROM order_items USING orders WHERE orders.id = order_items.order_id AND order_items.tenant_id = 7 AND orders.tenant_id = 7": DeleteInstance().
+			Delete("order_items").
+			Using("orders").
+			Where("orders.id", Eq, ValueField("order_items.order_id")),
+		"INSERT INTO orders (status, tenant_id) VALUES ('new', 7), ('paid', 7)": InsertInstance().
+			Insert("orders", "status").
+			Row("new").
+			Row("paid"),
+		"INSERT INTO orders (status, tenant_id) VALUES ('new', 7)": InsertInstance().
+			Insert("orders", "status", "tenant_id").
+			Row("new", 7),
+		"INSERT INTO orders (status, tenant_id) VALUES ('paid', 7)": InsertInstance().
+			Insert("orders", "status", "tenant_id").
+			Row("paid", int64(7)),
+		"UPDATE orders SET tenant_id = 7 WHERE id = 1 AND orders.tenant_id = 7": UpdateInstance().
+			Update("orders").
+			Set("tenant_id", int32(7)).
+			Where("id", Eq, 1),
+	}
+
+	for expected, node := range testCases {
+		if err := tenantScope.Apply(node); err != nil {
+			t.Fatal(err)
+		}
+
+		if node.String() != expected {
+			t.Fatalf(`Query %s != %s`, node.String(), expected)
+		}
+	}
+}
+
+// TestScopeViolation
+func TestScopeViolation(t *testing.T) {
+	testCases := map[string]Node{
+		"other tenant":  InsertInstance().Insert("orders", "status", "tenant_id").Row("new", 8),
+		"no columns":    InsertInstance().Insert("orders").Row(1, "new"),
+		"select":        InsertInstance().Insert("orders", "status").Query(QueryInstance().Select("status").From("drafts")),
+		"moved tenant":  UpdateInstance().Update("orders").Set("tenant_id", 8).Where("id", Eq, 1),
+		"aliased table": UpdateInstance().Update("orders", "o").Set("o.tenant_id", 8).Where("o.id", Eq, 1),
+		"column list":   UpdateInstance().Update("orders").Set([]string{"status", "tenant_id"}, []any{"paid", 8}).Where("id", Eq, 1),
+		"subquery":      UpdateInstance().Update("orders").Set([]string{"tenant_id"}, QueryInstance().Select("tenant_id").From("drafts")).Where("id", Eq, 1),
+	}
+
+	for name, node := range testCases {
+		if err := tenantScope.Apply(node); !errors.Is(err, ErrScopeViolation) {
+			t.Fatalf(`Error %s: %v`, name, err)
+		}
+	}
+}
+
+// TestScopeExecutor
+func TestScopeExecutor(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	var events []string
+
+	hook := &recordHook{name: "audit", events: &events}
+	executor := NewExecutor(db, hook).WithScope(tenantScope)
+
+	ctx := WithScope(context.Background(), NewScope(ScopeRule{Table: "orders", Column: "region", Value: "eu"}))
+
+	if _, err := DeleteInstance().Delete("orders").Where("id", Eq, 1).ExecContext(ctx, executor); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "DELETE FROM orders WHERE id = $1 AND orders.region = $2 AND orders.tenant_id = $3"
+	if last := fake.Last(); last.SQL != expected || !reflect.DeepEqual(last.Args, []any{int64(1), "eu", int64(7)}) {
+		t.Fatalf(`Query %s != %s (%v)`, last.SQL, expected, last.Args)
+	}
+
+	// The guards are checked before the scope conditions are added
+	_, err := UpdateInstance().Update("orders").Set("status", "paid").ExecContext(ctx, executor)
+	if !errors.Is(err, ErrMissingWhere) {
+		t.Fatalf(`Error %v`, err)
+	}
+
+	// The transactions keep the scopes of the executor
+	err = InTx(ctx, executor, nil, func(tx Runner) error {
+		_, err := UpdateInstance().Update("orders").Set("status", "paid").Where("id", Eq, 1).ExecContext(ctx, tx)
+
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = "UPDATE orders SET status = $1 WHERE id = $2 AND orders.region = $3 AND orders.tenant_id = $4"
+	if queries := fake.Queries(); queries[len(queries)-2] != expected {
+		t.Fatalf(`Query %s != %s`, queries[len(queries)-2], expected)
+	}
+
+	// An unscoped statement is reported to the hooks
+	rows, err := QueryInstance().Select("COUNT(*)").From("orders").Unscoped().QueryContext(ctx, executor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = rows.Close()
+
+	if last := hook.stmts[len(hook.stmts)-1]; last.SQL != "SELECT COUNT(*) FROM orders" || !last.Unscoped {
+		t.Fatalf(`Statement %+v`, last)
+	}
+
+	// The compiled statements cannot be scoped when they run
+	compiled, err := QueryInstance().Select("*").From("orders").Where("id", Eq, Param("id")).Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = compiled.QueryContext(ctx, executor, map[string]any{"id": 1}); !errors.Is(err, ErrScopeViolation) {
+		t.Fatalf(`Error %v != %v`, err, ErrScopeViolation)
+	}
+
+	query := QueryInstance().Select("*").From("orders").Where("id", Eq, Param("id"))
+	if err = tenantScope.Apply(query); err != nil {
+		t.Fatal(err)
+	}
+
+	if compiled, err = query.Unscoped().Compile(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = compiled.QueryRowContext(context.Background(), executor, map[string]any{"id": 1}).Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = "SELECT * FROM orders WHERE id = $1 AND orders.tenant_id = $2"
+	if last := fake.Last(); last.SQL != expected {
+		t.Fatalf(`Query %s != %s`, last.SQL, expected)
+	}
+}
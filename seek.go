@@ -0,0 +1,379 @@
+package fluentsql
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ====================================================================
+//                   Seek :: Cursor
+// ====================================================================
+
+// EncodeCursor encodes the ORDER BY values of the last row of a page into an opaque cursor.
+//
+// Parameters:
+//   - values ...any: The values of the ORDER BY fields, in order.
+//
+// Returns:
+//   - string: The cursor, base64 URL encoded JSON.
+//   - error: An error if a value cannot be encoded.
+//
+// Example:
+//
+//	cursor, err := EncodeCursor(lastRow.CreatedAt, lastRow.ID)
+func EncodeCursor(values ...any) (string, error) {
+	values = append([]any(nil), values...)
+
+	for i, value := range values {
+		// Encode the column value of sql.Null* and other Valuer types
+		if valuer, ok := value.(driver.Valuer); ok {
+			v, err := valuer.Value()
+			if err != nil {
+				return "", err
+			}
+
+			values[i] = v
+		}
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor decodes a cursor of EncodeCursor into the ORDER BY values.
+// Integers are decoded as int64, other numbers as float64, and times as strings in RFC 3339 format.
+//
+// Parameters:
+//   - cursor string: The cursor.
+//
+// Returns:
+//   - []any: The values of the ORDER BY fields, in order.
+//   - error: ErrInvalidCursor if the cursor is malformed.
+func DecodeCursor(cursor string) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var values []any
+	if err = decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	for i, value := range values {
+		number, ok := value.(json.Number)
+		if !ok {
+			continue
+		}
+
+		if integer, err := number.Int64(); err == nil {
+			values[i] = integer
+		} else if float, err := number.Float64(); err == nil {
+			values[i] = float
+		}
+	}
+
+	return values, nil
+}
+
+// CursorOf encodes the cursor of a row according to the ORDER BY fields of the query.
+// The values are read from the `db` tags of a struct or from the keys of a map[string]any.
+// A qualified field such as u.created_at is looked up as u.created_at, then as created_at.
+//
+// Parameters:
+//   - row any: The last row of a page, a struct, a pointer to a struct or a map[string]any.
+//
+// Returns:
+//   - string: The cursor for SeekAfter.
+//   - error: An error if a value of the ORDER BY fields is missing.
+//
+// Example:
+//
+//	cursor, err := query.CursorOf(users[len(users)-1])
+func (qb *QueryBuilder) CursorOf(row any) (string, error) {
+	var values []any
+
+	for _, item := range qb.orderByStatement.Items {
+		value, ok := cursorValue(row, item.Field)
+		if !ok {
+			return "", fmt.Errorf("fluentsql: missing value of ORDER BY field %q in %T", item.Field, row)
+		}
+
+		values = append(values, value)
+	}
+
+	return EncodeCursor(values...)
+}
+
+// cursorValue returns the value of an ORDER BY field in a row.
+//
+// Parameters:
+//   - row any: A struct, a pointer to a struct or a map[string]any.
+//   - field string: The ORDER BY field.
+//
+// Returns:
+//   - any: The value.
+//   - bool: False if the row has no value for the field.
+func cursorValue(row any, field string) (any, bool) {
+	names := []string{field}
+	if dot := strings.LastIndex(field, "."); dot >= 0 {
+		names = append(names, field[dot+1:])
+	}
+
+	if m, ok := row.(map[string]any); ok {
+		for _, name := range names {
+			if value, ok := m[name]; ok {
+				return value, true
+			}
+		}
+
+		return nil, false
+	}
+
+	value, ok := structValue(row)
+	if !ok {
+		return nil, false
+	}
+
+	info := structInfoOf(value.Type())
+
+	for _, name := range names {
+		position, ok := info.byName[name]
+		if !ok {
+			continue
+		}
+
+		v := fieldValue(value, info.fields[position].Index)
+		if !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+			return nil, true
+		}
+
+		return v.Interface(), true
+	}
+
+	return nil, false
+}
+
+// ====================================================================
+//                   Seek :: Operators
+// ====================================================================
+
+// SeekAfter restricts the query to the rows after a cursor, according to the ORDER BY fields.
+// It replaces OFFSET for deep pages, the ORDER BY fields must be defined before and should identify
+// a row uniquely, e.g. ORDER BY created_at DESC, id DESC. An empty cursor selects the first page.
+// The fields are expected NOT NULL, the NULLS position of a nullable field must be set with OrderByNulls.
+//
+// Parameters:
+//   - cursor string: A cursor of CursorOf or EncodeCursor.
+//
+// Returns:
+//   - *QueryBuilder: The QueryBuilder instance, an invalid cursor is returned as ErrInvalidCursor by Sql.
+//
+// Examples:
+//
+//	SELECT id, name FROM users WHERE (created_at, id) < ('2024-01-02T03:04:05Z', 42) ORDER BY created_at DESC, id DESC LIMIT 20 OFFSET 0
+//	SELECT id, name FROM users WHERE (name > 'John' OR (name = 'John' AND id < 42)) ORDER BY name ASC, id DESC
+func (qb *QueryBuilder) SeekAfter(cursor string) *QueryBuilder {
+	if cursor == "" {
+		return qb
+	}
+
+	values, err := DecodeCursor(cursor)
+	if err != nil {
+		qb.setError(err)
+
+		return qb
+	}
+
+	return qb.SeekAfterValues(values...)
+}
+
+// SeekAfterValues restricts the query to the rows after the given ORDER BY values, see SeekAfter.
+//
+// Parameters:
+//   - values ...any: The values of the ORDER BY fields of the last row, in order.
+//
+// Returns:
+//   - *QueryBuilder: The QueryBuilder instance, a mismatch with the ORDER BY fields is returned as ErrInvalidCursor by Sql.
+func (qb *QueryBuilder) SeekAfterValues(values ...any) *QueryBuilder {
+	items := qb.orderByStatement.Items
+
+	if len(items) == 0 || len(values) != len(items) {
+		qb.setError(fmt.Errorf("%w: %d values for %d ORDER BY fields", ErrInvalidCursor, len(values), len(items)))
+
+		return qb
+	}
+
+	// Keep the OR conditions of the WHERE clause together, AND binds tighter than OR.
+	qb.whereStatement.Conditions = grouped(qb.whereStatement.Conditions)
+
+	qb.whereStatement.Append(seekCondition(items, values))
+
+	return qb
+}
+
+// setError keeps the first error of the builder methods.
+//
+// Parameters:
+//   - err error: The error.
+func (qb *QueryBuilder) setError(err error) {
+	if qb.err == nil {
+		qb.err = err
+	}
+}
+
+// seekCondition builds the condition selecting the rows after the values in the sort order.
+// A row value comparison is used when all fields have the same direction, no NULLS position and
+// non-NULL values, and the dialect supports row values. Otherwise, the comparison is expanded
+// into an OR-chain: a > ? OR (a = ? AND b > ?) OR ...
+//
+// Parameters:
+//   - items []SortItem: The ORDER BY items.
+//   - values []any: The values of the last row, one per item.
+//
+// Returns:
+//   - Condition: The seek condition.
+func seekCondition(items []SortItem, values []any) Condition {
+	if len(items) > 1 && rowValues() && isRowComparable(items, values) {
+		fields := make([]string, len(items))
+		markers := make([]string, len(items))
+
+		for i, item := range items {
+			fields[i] = item.Field
+			markers[i] = "?"
+		}
+
+		opt := Greater
+		if items[0].Direction == Desc {
+			opt = Lesser
+		}
+
+		return Condition{
+			Field: fmt.Sprintf("(%s)", strings.Join(fields, ", ")),
+			Opt:   opt,
+			Value: Expr(fmt.Sprintf("(%s)", strings.Join(markers, ", ")), values...),
+			AndOr: And,
+		}
+	}
+
+	var terms []Condition
+
+	for i, item := range items {
+		after, ok := seekAfterCondition(item, values[i])
+		if !ok {
+			continue
+		}
+
+		term := after
+		if i > 0 {
+			var group []Condition
+			for j := 0; j < i; j++ {
+				group = append(group, seekEqualCondition(items[j], values[j]))
+			}
+
+			term = Condition{Group: append(group, after)}
+		}
+
+		if len(terms) > 0 {
+			term.AndOr = Or
+		}
+
+		terms = append(terms, term)
+	}
+
+	// No row comes after the values
+	if len(terms) == 0 {
+		return Condition{Field: "1", Opt: Eq, Value: Expr("0"), AndOr: And}
+	}
+
+	if len(terms) == 1 {
+		return terms[0]
+	}
+
+	return Condition{Group: terms, AndOr: And}
+}
+
+// isRowComparable reports whether the seek condition can be a row value comparison.
+//
+// Parameters:
+//   - items []SortItem: The ORDER BY items.
+//   - values []any: The values of the last row.
+//
+// Returns:
+//   - bool: True if all items have the same direction, no NULLS position and a non-NULL value.
+func isRowComparable(items []SortItem, values []any) bool {
+	for i, item := range items {
+		if item.Direction != items[0].Direction || item.Nulls != NullsDefault || values[i] == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// seekEqualCondition builds the condition of a field equal to its value, NULL values included.
+//
+// Parameters:
+//   - item SortItem: The ORDER BY item.
+//   - value any: The value of the last row.
+//
+// Returns:
+//   - Condition: field = value, or field IS NULL.
+func seekEqualCondition(item SortItem, value any) Condition {
+	if value == nil {
+		return Condition{Field: item.Field, Opt: Null, AndOr: And}
+	}
+
+	return Condition{Field: item.Field, Opt: Eq, Value: value, AndOr: And}
+}
+
+// seekAfterCondition builds the condition of a field after its value in the sort order.
+// When NULL values come last, they are after any value. When they come first, any value is after NULL.
+// A field without NULLS position is expected NOT NULL, unless the value itself is NULL.
+//
+// Parameters:
+//   - item SortItem: The ORDER BY item.
+//   - value any: The value of the last row.
+//
+// Returns:
+//   - Condition: The condition.
+//   - bool: False if no value comes after the value, i.e. a NULL value sorted last.
+func seekAfterCondition(item SortItem, value any) (Condition, bool) {
+	nullsLast := item.nullsLast()
+
+	if value == nil {
+		if nullsLast {
+			return Condition{}, false
+		}
+
+		return Condition{Field: item.Field, Opt: NotNull, AndOr: And}, true
+	}
+
+	opt := Greater
+	if item.Direction == Desc {
+		opt = Lesser
+	}
+
+	after := Condition{Field: item.Field, Opt: opt, Value: value, AndOr: And}
+
+	if item.Nulls != NullsDefault && nullsLast {
+		return Condition{Group: []Condition{
+			after,
+			{Field: item.Field, Opt: Null, AndOr: Or},
+		}, AndOr: And}, true
+	}
+
+	return after, true
+}
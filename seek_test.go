@@ -0,0 +1,193 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// noRowValuesDialect is a PostgreSQL dialect without row value comparisons.
+type noRowValuesDialect struct {
+	PostgreSQLDialect
+}
+
+func (d noRowValuesDialect) RowValues() bool {
+	return false
+}
+
+// TestSeekAfter
+func TestSeekAfter(t *testing.T) {
+	testCases := map[string]*QueryBuilder{
+		"SELECT id, name FROM users WHERE status = 'active' AND (created_at, id) < ('2024-01-02', 42) ORDER BY created_at DESC, id DESC LIMIT 20 OFFSET 0": QueryInstance().
+			Select("id", "name").
+			From("users").
+			Where("status", Eq, "active").
+			OrderBy("created_at", Desc).
+			OrderBy("id", Desc).
+			SeekAfterValues("2024-01-02", 42).
+			Limit(20, 0),
+		"SELECT id, name FROM users WHERE (name > 'John' OR (name = 'John' AND id < 42)) ORDER BY name ASC, id DESC": QueryInstance().
+			Select("id", "name").
+			From("users").
+			OrderBy("name", Asc).
+			OrderBy("id", Desc).
+			SeekAfterValues("John", 42),
+		"SELECT id FROM users WHERE (role = 'admin' OR role = 'owner') AND id > 42 ORDER BY id ASC": QueryInstance().
+			Select("id").
+			From("users").
+			Where("role", Eq, "admin").
+			WhereOr("role", Eq, "owner").
+			OrderBy("id", Asc).
+			SeekAfterValues(42),
+		"SELECT id FROM users WHERE ((age > 30 OR age IS NULL) OR (age = 30 AND id > 7)) ORDER BY age ASC NULLS LAST, id ASC": QueryInstance().
+			Select("id").
+			From("users").
+			OrderByNulls("age", Asc, NullsLast).
+			OrderBy("id", Asc).
+			SeekAfterValues(30, 7),
+		"SELECT id FROM users WHERE (age IS NULL AND id > 7) ORDER BY age ASC NULLS LAST, id ASC": QueryInstance().
+			Select("id").
+			From("users").
+			OrderByNulls("age", Asc, NullsLast).
+			OrderBy("id", Asc).
+			SeekAfterValues(nil, 7),
+		"SELECT id FROM users WHERE (age IS NOT NULL OR (age IS NULL AND id > 7)) ORDER BY age ASC NULLS FIRST, id ASC": QueryInstance().
+			Select("id").
+			From("users").
+			OrderByNulls("age", Asc, NullsFirst).
+			OrderBy("id", Asc).
+			SeekAfterValues(nil, 7),
+		"SELECT id FROM users ORDER BY id ASC": QueryInstance().
+			Select("id").
+			From("users").
+			OrderBy("id", Asc).
+			SeekAfter(""),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
+
+// TestSeekAfterArgs
+func TestSeekAfterArgs(t *testing.T) {
+	var sql string
+	var args []any
+
+	query := QueryInstance().
+		Select("id").
+		From("users").
+		OrderBy("created_at", Desc).
+		OrderBy("id", Desc).
+		SeekAfterValues("2024-01-02", 42).
+		Limit(20, 0)
+
+	expected := "SELECT id FROM users WHERE (created_at, id) < ($1, $2) ORDER BY created_at DESC, id DESC LIMIT $3 OFFSET $4"
+	sql, args, _ = query.Sql()
+
+	if sql != expected || len(args) != 4 {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	SetDialect(new(noRowValuesDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query = QueryInstance().
+		Select("id").
+		From("users").
+		OrderBy("created_at", Desc).
+		OrderBy("id", Desc).
+		SeekAfterValues("2024-01-02", 42)
+
+	expected = "SELECT id FROM users WHERE (created_at < $1 OR (created_at = $2 AND id < $3)) ORDER BY created_at DESC, id DESC"
+	sql, args, _ = query.Sql()
+
+	if sql != expected || len(args) != 3 {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	// A dialect which is not a RowValuesDialect
+	SetDialect(new(basicDialect))
+
+	expected = "SELECT id FROM users WHERE (created_at < ? OR (created_at = ? AND id < ?)) ORDER BY created_at DESC, id DESC"
+	sql, args, _ = query.Sql()
+
+	if sql != expected || len(args) != 3 {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+}
+
+// TestSeekAfterMySQL
+func TestSeekAfterMySQL(t *testing.T) {
+	SetDialect(new(MySQLDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query := QueryInstance().
+		Select("id").
+		From("users").
+		OrderByNulls("age", Desc, NullsLast).
+		OrderBy("id", Desc).
+		SeekAfterValues(30, 7)
+
+	expected := "SELECT id FROM users WHERE ((age < ? OR age IS NULL) OR (age = ? AND id < ?)) ORDER BY age IS NULL ASC, age DESC, id DESC"
+	sql, args, _ := query.Sql()
+
+	if sql != expected || len(args) != 3 {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+}
+
+// TestSeekCursor
+func TestSeekCursor(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	user := testUser{
+		testTimestamps: testTimestamps{CreatedAt: created},
+		ID:             42,
+		Name:           "John",
+	}
+
+	query := QueryInstance().
+		Select("u.id", "u.name").
+		From("users", "u").
+		OrderBy("u.created_at", Desc).
+		OrderBy("u.id", Desc)
+
+	cursor, err := query.CursorOf(&user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := DecodeCursor(cursor)
+	if err != nil || len(values) != 2 || values[0] != "2024-01-02T03:04:05Z" || values[1] != int64(42) {
+		t.Fatalf(`Values %#v (%v)`, values, err)
+	}
+
+	expected := "SELECT u.id, u.name FROM users u WHERE (u.created_at, u.id) < ('2024-01-02T03:04:05Z', 42) ORDER BY u.created_at DESC, u.id DESC"
+	if query.Clone().SeekAfter(cursor).String() != expected {
+		t.Fatalf(`Query %s != %s`, query.Clone().SeekAfter(cursor).String(), expected)
+	}
+
+	cursor, err = QueryInstance().OrderBy("score", Asc).CursorOf(map[string]any{"score": 1.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if values, _ = DecodeCursor(cursor); values[0] != 1.5 {
+		t.Fatalf(`Values %#v`, values)
+	}
+
+	if _, err = query.CursorOf(map[string]any{"id": 1}); err == nil {
+		t.Fatalf(`CursorOf must fail without created_at`)
+	}
+
+	for _, invalid := range []*QueryBuilder{
+		query.Clone().SeekAfter("not a cursor"),
+		query.Clone().SeekAfterValues(1),
+	} {
+		if _, _, err = invalid.Sql(); !errors.Is(err, ErrInvalidCursor) {
+			t.Fatalf(`Error %v != %v`, err, ErrInvalidCursor)
+		}
+	}
+}
@@ -1,8 +1,8 @@
 package fluentsql
 
 import (
-	"fmt"
-	"strings"
+	"reflect"
+	"sync"
 )
 
 // Select clause
@@ -17,37 +17,98 @@ type Select struct {
 // Returns:
 // - A string representing the constructed SQL SELECT statement.
 func (s *Select) String() string {
-	// Default SELECT clause to "*"
-	selectOf := "*"
-
-	// Check if Columns is not empty
-	if len(s.Columns) > 0 {
-		var columns []string
-
-		// Process each column in Columns
-		for _, col := range s.Columns {
-			if valueCase, ok := col.(*Case); ok { // Column is of type Case
-				columns = append(columns, valueCase.String())
-			} else if valueString, ok := col.(string); ok { // Column is a plain string
-				columns = append(columns, valueString)
-			} else if valueFieldYear, ok := col.(FieldYear); ok { // Column is of type FieldYear
-				columns = append(columns, valueFieldYear.String())
-			} else if valueQueryBuilder, ok := col.(*QueryBuilder); ok { // Column is a QueryBuilder
-				selectQuery := valueQueryBuilder.String()
-
-				// Add parentheses if alias is not provided
-				if valueQueryBuilder.alias == "" {
-					selectQuery = fmt.Sprintf("(%s)", valueQueryBuilder)
-				}
-
-				columns = append(columns, selectQuery)
+	return inlineString(s)
+}
+
+// selectStructKey identifies a cached column list of SelectStruct and SelectStructAs.
+type selectStructKey struct {
+	typ     reflect.Type
+	alias   string
+	prefix  string
+	dialect string
+}
+
+// selectStructCache caches the column lists per struct type (selectStructKey -> []any).
+var selectStructCache sync.Map
+
+// SelectStruct expands the db-tagged fields of a struct type into a column list for QueryBuilder.Select.
+// The fields of tagged nested structs are skipped, use SelectStructAs to select them from their own table.
+//
+// Parameters:
+//   - alias: The table alias used to qualify the columns, no qualifier if empty.
+//
+// Returns:
+//   - []any: The columns, e.g. u.id, u.name.
+//
+// Example:
+//
+//	QueryInstance().Select(SelectStruct[User]("u")...).From("users", "u")
+//	// SELECT u.id, u.name FROM users u
+func SelectStruct[T any](alias string) []any {
+	return selectStructColumns(reflect.TypeOf((*T)(nil)).Elem(), alias, "")
+}
+
+// SelectStructAs expands the db-tagged fields of a struct type into a column list whose columns are
+// renamed with a prefix, so that the scanner fills the nested struct tagged with the prefix.
+//
+// Parameters:
+//   - alias: The table alias used to qualify the columns, no qualifier if empty.
+//   - prefix: The db tag of the nested struct field.
+//
+// Returns:
+//   - []any: The columns, e.g. c.id AS "company.id", c.name AS "company.name".
+//
+// Example:
+//
+//	QueryInstance().
+//	    Select(append(SelectStruct[User]("u"), SelectStructAs[Company]("c", "company")...)...).
+//	    From("users", "u").
+//	    Join(InnerJoin, "companies c", Condition{Field: "c.id", Opt: Eq, Value: ValueField("u.company_id")})
+func SelectStructAs[T any](alias, prefix string) []any {
+	return selectStructColumns(reflect.TypeOf((*T)(nil)).Elem(), alias, prefix)
+}
+
+// selectStructColumns builds, or loads from the cache, the column list of a struct type.
+//
+// Parameters:
+//   - typ: The struct type.
+//   - alias: The table alias used to qualify the columns.
+//   - prefix: The prefix of the column aliases, no column alias if empty.
+//
+// Returns:
+//   - []any: A copy of the column list.
+func selectStructColumns(typ reflect.Type, alias, prefix string) []any {
+	key := selectStructKey{
+		typ:     typ,
+		alias:   alias,
+		prefix:  prefix,
+		dialect: DefaultDialect().Name(),
+	}
+
+	cached, ok := selectStructCache.Load(key)
+	if !ok {
+		var columns []any
+
+		for _, field := range structFields(typ) {
+			if field.Nested {
+				continue
+			}
+
+			column := field.Name
+			if alias != "" {
+				column = alias + "." + column
 			}
+
+			if prefix != "" {
+				column += " AS " + quoteIdentifier(prefix+"."+field.Name)
+			}
+
+			columns = append(columns, column)
 		}
 
-		// Join all processed column representations with a comma
-		selectOf = strings.Join(columns, ", ")
+		cached, _ = selectStructCache.LoadOrStore(key, columns)
 	}
 
-	// Return the constructed SQL SELECT statement
-	return fmt.Sprintf("SELECT %s", selectOf)
+	// Return a copy, the callers may append to the column list.
+	return append([]any(nil), cached.([]any)...)
 }
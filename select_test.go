@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -46,3 +47,82 @@ func TestColumnFromSelect(t *testing.T) {
 		t.Fatalf(`Query %s != %s`, selectTest.String(), expected)
 	}
 }
+
+// TestSelectStruct
+func TestSelectStruct(t *testing.T) {
+	testCases := map[string]*QueryBuilder{
+		"SELECT created_at, id, name, email, age FROM users": QueryInstance().
+			Select(SelectStruct[testUser]("")...).
+			From("users"),
+		"SELECT u.created_at, u.id, u.name, u.email, u.age, c.id AS \"company.id\", c.name AS \"company.name\" FROM users u INNER JOIN companies c ON c.id = u.company_id": QueryInstance().
+			Select(append(SelectStruct[testUser]("u"), SelectStructAs[testCompany]("c", "company")...)...).
+			From("users", "u").
+			Join(InnerJoin, "companies c", Condition{
+				Field: "c.id",
+				Opt:   Eq,
+				Value: ValueField("u.company_id"),
+			}),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
+
+// TestSelectStructMySQL
+func TestSelectStructMySQL(t *testing.T) {
+	SetDialect(new(MySQLDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query := QueryInstance().
+		Select(SelectStructAs[testCompany]("c", "company")...).
+		From("companies", "c")
+	expected := "SELECT c.id AS `company.id`, c.name AS `company.name` FROM companies c"
+
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+}
+
+// basicDialect implements the methods of Dialect only, the optional interfaces fall back to their defaults.
+type basicDialect struct{}
+
+func (d basicDialect) Name() string {
+	return "Basic"
+}
+
+func (d basicDialect) Placeholder(_ int) string {
+	return "?"
+}
+
+func (d basicDialect) YearFunction(field string) string {
+	return "EXTRACT(YEAR FROM " + field + ")"
+}
+
+// TestSelectStructDialect
+func TestSelectStructDialect(t *testing.T) {
+	SetDialect(new(basicDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query := QueryInstance().
+		Select(SelectStructAs[testCompany]("c", "company")...).
+		From("companies", "c")
+	expected := "SELECT c.id AS \"company.id\", c.name AS \"company.name\" FROM companies c"
+
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+}
+
+// TestSelectStructCached
+func TestSelectStructCached(t *testing.T) {
+	columns := SelectStruct[testCompany]("c")
+	columns[0] = "changed"
+
+	expected := []any{"c.id", "c.name"}
+	if actual := SelectStruct[testCompany]("c"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(`Columns %v != %v`, actual, expected)
+	}
+}
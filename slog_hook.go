@@ -0,0 +1,105 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+)
+
+// SlogHookOptions defines the options of the log/slog hook.
+type SlogHookOptions struct {
+	// Level is the level of the successful statements, slog.LevelDebug if nil.
+	Level slog.Leveler
+	// SlowThreshold logs the statements taking longer at slog.LevelWarn with slow=true, disabled if zero.
+	SlowThreshold time.Duration
+	// LogArgs logs the arguments of the statements, they are redacted by default.
+	LogArgs bool
+}
+
+// slogHook logs the executed statements with log/slog.
+type slogHook struct {
+	logger *slog.Logger    // logger is the destination of the records.
+	opts   SlogHookOptions // opts are the options of the hook.
+}
+
+// NewSlogHook creates a Hook logging every statement with its kind, label and duration.
+// The failed statements are logged at slog.LevelError.
+//
+// Parameters:
+//   - logger (*slog.Logger): The logger, slog.Default() if nil.
+//   - opts (SlogHookOptions): The level, slow query threshold and argument redaction.
+//
+// Returns:
+//   - Hook: The hook, to be registered with AddHook or NewExecutor.
+//
+// Example:
+//
+//	fluentsql.AddHook(fluentsql.NewSlogHook(logger, fluentsql.SlogHookOptions{SlowThreshold: 200 * time.Millisecond}))
+func NewSlogHook(logger *slog.Logger, opts SlogHookOptions) Hook {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	if opts.Level == nil {
+		opts.Level = slog.LevelDebug
+	}
+
+	return &slogHook{
+		logger: logger,
+		opts:   opts,
+	}
+}
+
+// BeforeQuery returns the context unchanged.
+func (h *slogHook) BeforeQuery(ctx context.Context, _ Statement) context.Context {
+	return ctx
+}
+
+// AfterQuery logs the statement.
+func (h *slogHook) AfterQuery(ctx context.Context, stmt Statement, _ sql.Result, err error, duration time.Duration) {
+	level := h.opts.Level.Level()
+	slow := h.opts.SlowThreshold > 0 && duration > h.opts.SlowThreshold
+
+	if slow {
+		level = slog.LevelWarn
+	}
+
+	if err != nil {
+		level = slog.LevelError
+	}
+
+	if !h.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("sql", stmt.SQL),
+		slog.String("kind", string(stmt.Kind)),
+		slog.Duration("duration", duration),
+	}
+
+	if stmt.Label != "" {
+		attrs = append(attrs, slog.String("label", stmt.Label))
+	}
+
+	if h.opts.LogArgs {
+		attrs = append(attrs, slog.Any("args", stmt.Args))
+	} else {
+		attrs = append(attrs, slog.Int("args", len(stmt.Args)))
+	}
+
+	if stmt.Unscoped {
+		attrs = append(attrs, slog.Bool("unscoped", true))
+	}
+
+	if slow {
+		attrs = append(attrs, slog.Bool("slow", true))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	h.logger.LogAttrs(ctx, level, "fluentsql: query", attrs...)
+}
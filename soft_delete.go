@@ -0,0 +1,199 @@
+package fluentsql
+
+import (
+	"slices"
+	"sync"
+)
+
+// ====================================================================
+//                   Soft delete :: Structure
+// ====================================================================
+
+// trashedMode selects the soft deleted rows read by a QueryBuilder.
+type trashedMode int
+
+const (
+	excludeTrashed trashedMode = iota // The soft deleted rows are excluded (default).
+	withTrashed                       // The soft deleted rows are included, see WithTrashed.
+	onlyTrashed                       // Only the soft deleted rows of the FROM tables are read, see OnlyTrashed.
+)
+
+var (
+	// softDeleteMu guards softDeletes.
+	softDeleteMu sync.RWMutex
+	// softDeletes maps the soft deleted tables to their deletion timestamp column.
+	softDeletes map[string]string
+)
+
+// ====================================================================
+//                   Soft delete :: Operators
+// ====================================================================
+
+// SetSoftDelete configures the soft deletes of a table. The DELETE statements of the table set the
+// column to the current timestamp of the dialect instead of removing the rows, and the SELECT
+// statements reading the table, in FROM or JOIN, skip the rows whose column is not NULL.
+//
+// Parameters:
+//   - table (string): The name of the table.
+//   - column (string): The deletion timestamp column, e.g. deleted_at. An empty column removes the configuration.
+//
+// Example:
+//
+//	fluentsql.SetSoftDelete("users", "deleted_at")
+//
+//	// UPDATE users SET deleted_at = NOW() WHERE id = $1 AND users.deleted_at IS NULL
+//	fluentsql.DeleteInstance().Delete("users").Where("id", fluentsql.Eq, 1)
+//
+//	// SELECT * FROM users WHERE users.deleted_at IS NULL
+//	fluentsql.QueryInstance().Select("*").From("users")
+func SetSoftDelete(table, column string) {
+	softDeleteMu.Lock()
+	defer softDeleteMu.Unlock()
+
+	configured := make(map[string]string, len(softDeletes)+1)
+	for name, deletedAt := range softDeletes {
+		configured[name] = deletedAt
+	}
+
+	if column == "" {
+		delete(configured, table)
+	} else {
+		configured[table] = column
+	}
+
+	softDeletes = configured
+}
+
+// softDeleteColumns returns the soft deleted tables. The map is replaced, never modified, by SetSoftDelete.
+//
+// Returns:
+//   - map[string]string: The deletion timestamp columns by table, nil if there is none.
+func softDeleteColumns() map[string]string {
+	softDeleteMu.RLock()
+	defer softDeleteMu.RUnlock()
+
+	return softDeletes
+}
+
+// WithTrashed includes the soft deleted rows in the result of the SELECT statement.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance.
+func (qb *QueryBuilder) WithTrashed() *QueryBuilder {
+	qb.trashed = withTrashed
+
+	return qb
+}
+
+// OnlyTrashed reads the soft deleted rows of the FROM table only, the joined tables still skip their
+// soft deleted rows.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance.
+func (qb *QueryBuilder) OnlyTrashed() *QueryBuilder {
+	qb.trashed = onlyTrashed
+
+	return qb
+}
+
+// HardDelete removes the rows of a soft deleted table instead of setting their deletion timestamp.
+//
+// Returns:
+//   - *DeleteBuilder: The DeleteBuilder instance.
+func (db *DeleteBuilder) HardDelete() *DeleteBuilder {
+	db.hardDelete = true
+
+	return db
+}
+
+// softDeleteClauses returns the JOIN and WHERE clauses of the SELECT statement with the conditions
+// on the deletion timestamp of the soft deleted tables. The clauses of the builder are not modified.
+//
+// Returns:
+// - Join: The JOIN clauses, an ON condition is added to the soft deleted tables.
+// - Where: The WHERE clause, a condition is added for the soft deleted FROM table.
+func (qb *QueryBuilder) softDeleteClauses() (Join, Where) {
+	columns := softDeleteColumns()
+	if len(columns) == 0 || qb.trashed == withTrashed {
+		return qb.joinStatement, qb.whereStatement
+	}
+
+	join := Join{Items: slices.Clone(qb.joinStatement.Items)}
+	where := Where{Conditions: slices.Clip(qb.whereStatement.Conditions)}
+
+	fromOpt := Null
+	if qb.trashed == onlyTrashed {
+		fromOpt = NotNull
+	}
+
+	filterTables(softDeleteFilter(columns, fromOpt), &where, &Join{}, qb.fromStatement)
+	filterTables(softDeleteFilter(columns, Null), &where, &join)
+
+	return join, where
+}
+
+// softDeleted returns the UPDATE statement replacing the DELETE statement of a soft deleted table.
+// The joins and USING tables are kept and skip their soft deleted rows, the rows already deleted are
+// left unchanged. The ORDER BY and LIMIT clauses are left to the DELETE statement.
+//
+// Returns:
+//   - *UpdateBuilder: The UPDATE statement setting the deletion timestamp.
+//   - bool: false if the table is not soft deleted or HardDelete was called.
+func (db *DeleteBuilder) softDeleted() (*UpdateBuilder, bool) {
+	table, ok := db.deleteStatement.Table.(string)
+	if !ok || db.hardDelete {
+		return nil, false
+	}
+
+	columns := softDeleteColumns()
+
+	column, ok := columns[table]
+	if !ok {
+		return nil, false
+	}
+
+	update := &UpdateBuilder{
+		updateStatement: Update{Table: table, Alias: db.deleteStatement.Alias},
+		whereStatement:  Where{Conditions: slices.Clip(db.whereStatement.Conditions)},
+		allRows:         true,
+	}
+
+	// The joined tables of MySQL may have the same column
+	if IsDialect(MySQL) {
+		_, ref := tableRef(table, db.deleteStatement.Alias)
+		column = ref + "." + column
+	}
+
+	update.setStatement.Append(column, ValueField(now()))
+
+	// The USING tables become the FROM list of the UPDATE statement
+	for _, using := range db.usingStatement {
+		update.joinStatement.Append(JoinItem{Join: CrossJoin, Table: using})
+	}
+
+	update.joinStatement.Items = append(update.joinStatement.Items, db.joinStatement.Items...)
+
+	filterTables(softDeleteFilter(columns, Null), &update.whereStatement, &update.joinStatement,
+		From{Table: table, Alias: db.deleteStatement.Alias})
+
+	return update, true
+}
+
+// softDeleteFilter returns the condition on the deletion timestamp of the soft deleted tables.
+//
+// Parameters:
+//   - columns (map[string]string): The deletion timestamp columns by table.
+//   - opt (WhereOpt): Null to skip the soft deleted rows, NotNull to read them only.
+//
+// Returns:
+//   - tableFilter: The filter of filterTables.
+func softDeleteFilter(columns map[string]string, opt WhereOpt) tableFilter {
+	return func(table, ref string) []Condition {
+		column, ok := columns[table]
+		if !ok {
+			return nil
+		}
+
+		return []Condition{{Field: ref + "." + column, Opt: opt}}
+	}
+}
@@ -0,0 +1,150 @@
+package fluentsql
+
+import (
+	"reflect"
+	"testing"
+)
+
+// softDeleteUsers configures the soft deletes of the users table for the duration of a test.
+func softDeleteUsers(t *testing.T) {
+	SetSoftDelete("users", "deleted_at")
+	t.Cleanup(func() { SetSoftDelete("users", "") })
+}
+
+// TestSoftDeleteQuery
+func TestSoftDeleteQuery(t *testing.T) {
+	softDeleteUsers(t)
+
+	testCases := map[string]*QueryBuilder{
+		"SELECT * FROM users WHERE users.deleted_at IS NULL": QueryInstance().
+			Select("*").
+			From("users"),
+		"SELECT * FROM users u WHERE (u.role = 'admin' OR u.role = 'owner') AND u.deleted_at IS NULL": QueryInstance().
+			Select("*").
+			From("users", "u").
+			Where("u.role", Eq, "admin").
+			WhereOr("u.role", Eq, "owner"),
+		"SELECT * FROM orders o LEFT JOIN users u ON (u.id = o.user_id AND u.deleted_at IS NULL)": QueryInstance().
+			Select("*").
+			From("orders", "o").
+			Join(LeftJoin, "users u", Condition{Field: "u.id", Opt: Eq, Value: ValueField("o.user_id")}),
+		"SELECT * FROM orders WHERE user_id IN (SELECT id FROM users WHERE users.deleted_at IS NULL)": QueryInstance().
+			Select("*").
+			From("orders").
+			Where("user_id", In, QueryInstance().Select("id").From("users")),
+		"SELECT * FROM users": QueryInstance().
+			Select("*").
+			From("users").
+			WithTrashed(),
+		"SELECT * FROM users u INNER JOIN users m ON (m.id = u.manager_id AND m.deleted_at IS NULL) WHERE u.deleted_at IS NOT NULL": QueryInstance().
+			Select("*").
+			From("users", "u").
+			Join(InnerJoin, "users m", Condition{Field: "m.id", Opt: Eq, Value: ValueField("u.manager_id")}).
+			OnlyTrashed(),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+
+	// The builder is not modified
+	query := QueryInstance().Select("*").From("users").Where("id", Eq, 1)
+	_, _, _ = query.Sql()
+
+	SetSoftDelete("users", "")
+
+	if sql, _, _ := query.Sql(); sql != "SELECT * FROM users WHERE id = $1" {
+		t.Fatalf(`Query %s`, sql)
+	}
+}
+
+// TestSoftDeleteDelete
+func TestSoftDeleteDelete(t *testing.T) {
+	softDeleteUsers(t)
+
+	sql, args, err := DeleteInstance().Delete("users").Where("id", Eq, 1).Sql()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "UPDATE users SET deleted_at = NOW() WHERE id = $1 AND users.deleted_at IS NULL"
+	if sql != expected || !reflect.DeepEqual(args, []any{1}) {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	testCases := map[string]*DeleteBuilder{
+		"DELETE FROM users WHERE id = 1": DeleteInstance().
+			Delete("users").
+			Where("id", Eq, 1).
+			HardDelete(),
+		"DELETE FROM sessions WHERE user_id = 1": DeleteInstance().
+			Delete("sessions").
+			Where("user_id", Eq, 1),
+		"UPDATE users u SET deleted_at = NOW() FROM teams WHERE teams.id = u.team_id AND teams.archived = true AND u.deleted_at IS NULL": DeleteInstance().
+			Delete("users", "u").
+			Using("teams").
+			Where("teams.id", Eq, ValueField("u.team_id")).
+			Where("teams.archived", Eq, true),
+		"UPDATE users u SET deleted_at = NOW() FROM users m WHERE (m.id = u.manager_id AND m.deleted_at IS NULL) AND m.role = 'intern' AND u.deleted_at IS NULL": DeleteInstance().
+			Delete("users", "u").
+			Join(InnerJoin, "users m", Condition{Field: "m.id", Opt: Eq, Value: ValueField("u.manager_id")}).
+			Where("m.role", Eq, "intern"),
+	}
+
+	for expected, del := range testCases {
+		if del.String() != expected {
+			t.Fatalf(`Query %s != %s`, del.String(), expected)
+		}
+	}
+
+	// The guards apply to the soft deletes
+	if _, _, err = DeleteInstance().Delete("users").Sql(); err == nil {
+		t.Fatal(`DELETE without WHERE clause`)
+	}
+}
+
+// TestSoftDeleteDialects
+func TestSoftDeleteDialects(t *testing.T) {
+	softDeleteUsers(t)
+	defer SetDialect(new(PostgreSQLDialect))
+
+	testCases := map[string]Dialect{
+		"UPDATE users SET users.deleted_at = NOW() WHERE id = ? AND users.deleted_at IS NULL LIMIT ?":       new(MySQLDialect),
+		"UPDATE users SET deleted_at = CURRENT_TIMESTAMP WHERE id = ? AND users.deleted_at IS NULL LIMIT ?": new(SQLiteDialect),
+	}
+
+	for expected, dialect := range testCases {
+		SetDialect(dialect)
+
+		sql, _, err := DeleteInstance().Delete("users").Where("id", Eq, 1).Limit(10).Sql()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if sql != expected {
+			t.Fatalf(`Query %s != %s`, sql, expected)
+		}
+	}
+
+	// A dialect which is not a NowDialect
+	SetDialect(new(basicDialect))
+
+	if sql, _, _ := DeleteInstance().Delete("users").Where("id", Eq, 1).Sql(); sql != "UPDATE users SET deleted_at = CURRENT_TIMESTAMP WHERE id = ? AND users.deleted_at IS NULL" {
+		t.Fatalf(`Query %s`, sql)
+	}
+
+	// The SET column is qualified for the joined tables of MySQL
+	SetDialect(new(MySQLDialect))
+
+	del := DeleteInstance().
+		Delete("users", "u").
+		Join(InnerJoin, "users m", Condition{Field: "m.id", Opt: Eq, Value: ValueField("u.manager_id")}).
+		Where("m.role", Eq, "intern")
+
+	expected := "UPDATE users u INNER JOIN users m ON (m.id = u.manager_id AND m.deleted_at IS NULL) SET u.deleted_at = NOW() WHERE m.role = 'intern' AND u.deleted_at IS NULL"
+	if del.String() != expected {
+		t.Fatalf(`Query %s != %s`, del.String(), expected)
+	}
+}
@@ -0,0 +1,337 @@
+package fluentsql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+// ====================================================================
+//                   Statement cache :: Structure
+// ====================================================================
+
+// DefaultStmtCacheSize is the number of prepared statements kept by WithStmtCache when the size is not positive.
+const DefaultStmtCacheSize = 64
+
+// stmtPreparer prepares statements. It is satisfied by *sql.DB, *sql.Tx and *sql.Conn.
+type stmtPreparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// stmtCache is a LRU cache of the statements prepared on one database, transaction or connection.
+type stmtCache struct {
+	mu       sync.Mutex
+	preparer stmtPreparer             // preparer is the runner the statements are prepared on.
+	size     int                      // size is the maximum number of cached statements.
+	order    *list.List               // order lists the entries, the most recently used first.
+	entries  map[string]*list.Element // entries maps the SQL text to its element of order.
+	closed   bool                     // closed is set by close, no statement is cached afterwards.
+}
+
+// stmtEntry is a cached statement.
+type stmtEntry struct {
+	query   string    // query is the SQL text of the statement.
+	stmt    *sql.Stmt // stmt is the prepared statement.
+	refs    int       // refs is the number of statements running on stmt.
+	evicted bool      // evicted is set when the entry leaves the cache, stmt is closed when refs drops to 0.
+}
+
+// cachedRunner runs the statements of runStatement on the prepared statements of a cache.
+type cachedRunner struct {
+	cache  *stmtCache
+	runner Runner // runner is the unwrapped database, transaction or connection.
+}
+
+// ====================================================================
+//                   Statement cache :: Operators
+// ====================================================================
+
+// WithStmtCache returns a copy of the executor which prepares its statements once and reuses them.
+// The statements are cached per SQL text; as the placeholders are deterministic, the builders of the
+// same shape share one statement. The least recently used statement is closed when the cache is full.
+//
+// The statements are prepared on the wrapped runner and live on its database, transaction or connection.
+// The transactions started with InTx on an executor of a *sql.DB do not use the cache: binding a statement
+// of the database to a transaction prepares it again on every call. A statement which cannot be prepared
+// runs unprepared, and database/sql retries the statements failing with driver.ErrBadConn on another
+// connection.
+//
+// Parameters:
+//   - size (int): The maximum number of cached statements, DefaultStmtCacheSize if not positive.
+//
+// Returns:
+//   - *Executor: The executor with the cache, to be closed with Close.
+//
+// Example:
+//
+//	executor := fluentsql.NewExecutor(db).WithStmtCache(128)
+//	defer executor.Close()
+//
+//	users, err := fluentsql.All[User](ctx, executor, query)
+func (e *Executor) WithStmtCache(size int) *Executor {
+	if size <= 0 {
+		size = DefaultStmtCacheSize
+	}
+
+	executor := &Executor{
+		runner: e.runner,
+		hooks:  e.hooks,
+		scopes: e.scopes,
+	}
+
+	runner := e.runner
+	for {
+		inner, ok := runner.(*Executor)
+		if !ok {
+			break
+		}
+
+		runner = inner.runner
+	}
+
+	if preparer, ok := runner.(stmtPreparer); ok {
+		executor.cache = &stmtCache{
+			preparer: preparer,
+			size:     size,
+			order:    list.New(),
+			entries:  make(map[string]*list.Element),
+		}
+	}
+
+	return executor
+}
+
+// Close closes the cached statements of the executor. The statements running on the executor
+// afterwards are not prepared. It does not close the wrapped runner.
+//
+// Returns:
+//   - error: The errors of the closed statements.
+func (e *Executor) Close() error {
+	if e.cache == nil {
+		return nil
+	}
+
+	return e.cache.close()
+}
+
+// get returns the cached statement of a query, prepared if missing. The statement is released with release.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the preparation.
+//   - query (string): The SQL text.
+//
+// Returns:
+//   - *stmtEntry: The entry of the statement.
+//   - error: The error of the preparation, or of a closed cache.
+func (c *stmtCache) get(ctx context.Context, query string) (*stmtEntry, error) {
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+
+		return nil, errStmtCacheClosed
+	}
+
+	if element, ok := c.entries[query]; ok {
+		c.order.MoveToFront(element)
+
+		entry := element.Value.(*stmtEntry)
+		entry.refs++
+
+		c.mu.Unlock()
+
+		return entry, nil
+	}
+
+	c.mu.Unlock()
+
+	// Prepare without holding the lock, the statement of a concurrent preparation wins
+	stmt, err := c.preparer.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+		_ = stmt.Close()
+
+		return nil, errStmtCacheClosed
+	}
+
+	if element, ok := c.entries[query]; ok {
+		c.order.MoveToFront(element)
+
+		entry := element.Value.(*stmtEntry)
+		entry.refs++
+
+		c.mu.Unlock()
+		_ = stmt.Close()
+
+		return entry, nil
+	}
+
+	entry := &stmtEntry{query: query, stmt: stmt, refs: 1}
+	c.entries[query] = c.order.PushFront(entry)
+
+	var stale []*sql.Stmt
+
+	for c.order.Len() > c.size {
+		if evictedStmt := c.evict(c.order.Back()); evictedStmt != nil {
+			stale = append(stale, evictedStmt)
+		}
+	}
+
+	c.mu.Unlock()
+
+	for _, staleStmt := range stale {
+		_ = staleStmt.Close()
+	}
+
+	return entry, nil
+}
+
+// release marks a statement of get as no longer running, and closes it if it was evicted meanwhile.
+//
+// Parameters:
+//   - entry (*stmtEntry): The entry returned by get.
+func (c *stmtCache) release(entry *stmtEntry) {
+	c.mu.Lock()
+
+	entry.refs--
+	closeStmt := entry.evicted && entry.refs == 0
+
+	c.mu.Unlock()
+
+	if closeStmt {
+		_ = entry.stmt.Close()
+	}
+}
+
+// evict removes an element of the cache. The caller holds the lock.
+//
+// Parameters:
+//   - element (*list.Element): The element.
+//
+// Returns:
+//   - *sql.Stmt: The statement to close, nil if it is still running and is closed by release.
+func (c *stmtCache) evict(element *list.Element) *sql.Stmt {
+	entry := element.Value.(*stmtEntry)
+
+	c.order.Remove(element)
+	delete(c.entries, entry.query)
+
+	entry.evicted = true
+	if entry.refs > 0 {
+		return nil
+	}
+
+	return entry.stmt
+}
+
+// close evicts all the statements of the cache.
+//
+// Returns:
+//   - error: The errors of the closed statements.
+func (c *stmtCache) close() error {
+	c.mu.Lock()
+
+	c.closed = true
+
+	var stale []*sql.Stmt
+
+	for c.order.Len() > 0 {
+		if stmt := c.evict(c.order.Back()); stmt != nil {
+			stale = append(stale, stmt)
+		}
+	}
+
+	c.mu.Unlock()
+
+	var errs []error
+
+	for _, stmt := range stale {
+		errs = append(errs, stmt.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+// errStmtCacheClosed is returned by get when the executor was closed, the statement then runs unprepared.
+var errStmtCacheClosed = errors.New("fluentsql: statement cache is closed")
+
+// ExecContext runs a statement without returning any rows on its cached statement.
+func (r *cachedRunner) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return runCached(ctx, r, query, func(stmt *sql.Stmt) (sql.Result, error) {
+		return stmt.ExecContext(ctx, args...)
+	}, func() (sql.Result, error) {
+		return r.runner.ExecContext(ctx, query, args...)
+	})
+}
+
+// QueryContext runs a statement that returns rows on its cached statement.
+func (r *cachedRunner) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return runCached(ctx, r, query, func(stmt *sql.Stmt) (*sql.Rows, error) {
+		return stmt.QueryContext(ctx, args...)
+	}, func() (*sql.Rows, error) {
+		return r.runner.QueryContext(ctx, query, args...)
+	})
+}
+
+// QueryRowContext runs a statement that is expected to return at most one row on its cached statement.
+func (r *cachedRunner) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	row, _ := runCached(ctx, r, query, func(stmt *sql.Stmt) (*sql.Row, error) {
+		row := stmt.QueryRowContext(ctx, args...)
+
+		return row, row.Err()
+	}, func() (*sql.Row, error) {
+		row := r.runner.QueryRowContext(ctx, query, args...)
+
+		return row, row.Err()
+	})
+
+	return row
+}
+
+// runCached runs a statement on its cached statement.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statement.
+//   - r (*cachedRunner): The cache and the unwrapped runner.
+//   - query (string): The SQL text.
+//   - run (func(stmt *sql.Stmt) (T, error)): Runs the prepared statement.
+//   - direct (func() (T, error)): Runs the statement unprepared, when it cannot be prepared.
+//
+// Returns:
+//   - T: The value of run.
+//   - error: The error of run.
+func runCached[T any](ctx context.Context, r *cachedRunner, query string, run func(stmt *sql.Stmt) (T, error), direct func() (T, error)) (T, error) {
+	entry, err := r.cache.get(ctx, query)
+	if err != nil {
+		return direct()
+	}
+
+	defer r.cache.release(entry)
+
+	return run(entry.stmt)
+}
+
+// cachedRunnerOf returns the runner of the statements of an executor cache.
+//
+// Parameters:
+//   - cache (*stmtCache): The cache of the executor.
+//   - runner (Runner): The unwrapped database, transaction or connection.
+//
+// Returns:
+//   - Runner: A cachedRunner if the statements of runner can use the cache, runner otherwise.
+func cachedRunnerOf(cache *stmtCache, runner Runner) Runner {
+	// The transactions of the database run unprepared, tx.StmtContext would prepare on every call
+	if preparer, ok := runner.(stmtPreparer); ok && preparer == cache.preparer {
+		return &cachedRunner{cache: cache, runner: runner}
+	}
+
+	return runner
+}
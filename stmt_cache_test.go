@@ -0,0 +1,136 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+)
+
+// TestStmtCache
+func TestStmtCache(t *testing.T) {
+	db, fake := newFakeDB(t, func(string, []any) fakeResult {
+		return fakeResult{Columns: []string{"id"}, Rows: [][]any{{int64(1)}}, RowsAffected: 1}
+	})
+
+	executor := NewExecutor(db).WithStmtCache(10)
+	defer executor.Close()
+
+	ctx := context.Background()
+
+	// The same shape is prepared once, whatever the arguments
+	for id := 1; id <= 3; id++ {
+		if _, err := UpdateInstance().Update("users").Set("name", "John").Where("id", Eq, id).ExecContext(ctx, executor); err != nil {
+			t.Fatal(err)
+		}
+
+		var count int
+		if err := QueryInstance().Select("COUNT(*)").From("users").Where("id", Eq, id).QueryRowContext(ctx, executor).Scan(&count); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if fake.prepares != 2 {
+		t.Fatalf(`Prepares %d != 2`, fake.prepares)
+	}
+
+	if err := executor.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if fake.closedStmts != 2 {
+		t.Fatalf(`Closed statements %d != 2`, fake.closedStmts)
+	}
+
+	// A closed cache runs the statements unprepared
+	if _, err := executor.ExecContext(ctx, "DELETE FROM sessions"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestStmtCacheEviction
+func TestStmtCacheEviction(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	executor := NewExecutor(db).WithStmtCache(1)
+	defer executor.Close()
+
+	ctx := context.Background()
+
+	for _, table := range []string{"users", "orders", "users"} {
+		if _, err := DeleteInstance().Delete(table).Where("id", Eq, 1).ExecContext(ctx, executor); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if fake.prepares != 3 {
+		t.Fatalf(`Prepares %d != 3`, fake.prepares)
+	}
+
+	if fake.closedStmts != 2 {
+		t.Fatalf(`Closed statements %d != 2`, fake.closedStmts)
+	}
+}
+
+// TestStmtCacheBadConn
+func TestStmtCacheBadConn(t *testing.T) {
+	failures := 0
+
+	db, fake := newFakeDB(t, func(query string, _ []any) fakeResult {
+		// database/sql retries the statement on another connection
+		if strings.HasPrefix(query, "UPDATE") && failures < 2 {
+			failures++
+
+			return fakeResult{Err: driver.ErrBadConn}
+		}
+
+		return fakeResult{RowsAffected: 1}
+	})
+
+	executor := NewExecutor(db).WithStmtCache(10)
+	defer executor.Close()
+
+	if _, err := UpdateInstance().Update("users").Set("name", "John").Where("id", Eq, 1).ExecContext(context.Background(), executor); err != nil {
+		t.Fatal(err)
+	}
+
+	if fake.Last().SQL != "UPDATE users SET name = $1 WHERE id = $2" {
+		t.Fatalf(`Query %s`, fake.Last().SQL)
+	}
+}
+
+// TestStmtCacheInTx
+func TestStmtCacheInTx(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+	db.SetMaxOpenConns(1)
+
+	executor := NewExecutor(db).WithStmtCache(10)
+	defer executor.Close()
+
+	ctx := context.Background()
+	del := DeleteInstance().Delete("sessions").Where("user_id", Eq, 1)
+
+	if _, err := del.ExecContext(ctx, executor); err != nil {
+		t.Fatal(err)
+	}
+
+	// The transaction runs unprepared
+	err := InTx(ctx, executor, nil, func(tx Runner) error {
+		_, err := del.ExecContext(ctx, tx)
+
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The fake driver prepares the unprepared statements itself
+	if fake.prepares != 2 {
+		t.Fatalf(`Prepares %d != 2`, fake.prepares)
+	}
+
+	expected := "DELETE FROM sessions WHERE user_id = $1,BEGIN,DELETE FROM sessions WHERE user_id = $1,COMMIT"
+	if queries := strings.Join(fake.Queries(), ","); queries != expected {
+		t.Fatalf(`Queries %s != %s`, queries, expected)
+	}
+}
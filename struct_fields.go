@@ -0,0 +1,250 @@
+package fluentsql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// structTag is the struct tag holding the column name of a field.
+const structTag = "db"
+
+// structField describes a struct field mapped to a table column.
+type structField struct {
+	// Name is the column name taken from the db tag, prefixed by the tags of the nested structs (e.g. user.id).
+	Name string
+	// Index is the index sequence of the field for reflect.Value.FieldByIndex.
+	Index []int
+	// Nested reports whether the field belongs to a tagged nested struct, e.g. a joined table.
+	Nested bool
+}
+
+// structInfo holds the mapped fields of a struct type.
+type structInfo struct {
+	// fields are the mapped fields in declaration order.
+	fields []structField
+	// byName maps a column name to its position in fields.
+	byName map[string]int
+}
+
+// structInfoCache caches the mapped fields per struct type (reflect.Type -> *structInfo).
+var structInfoCache sync.Map
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	timeType    = reflect.TypeOf(time.Time{})
+)
+
+// structFields returns the fields of a struct type which are mapped to table columns.
+//
+// Only exported fields with a db tag are mapped, e.g. `db:"first_name"`. Fields tagged with `db:"-"`
+// are skipped, and the fields of embedded structs without a db tag are promoted to the parent struct.
+// The fields of a tagged struct field, e.g. `db:"user"`, are mapped with the tag as prefix (user.id),
+// unless the struct is a value type such as time.Time, sql.NullString or a driver.Valuer.
+// The result is cached per type.
+//
+// Parameters:
+//   - typ (reflect.Type): A struct type or a pointer to a struct type.
+//
+// Returns:
+//   - []structField: The mapped fields in declaration order.
+func structFields(typ reflect.Type) []structField {
+	return structInfoOf(typ).fields
+}
+
+// structInfoOf returns the cached mapped fields of a struct type.
+//
+// Parameters:
+//   - typ (reflect.Type): A struct type or a pointer to a struct type.
+//
+// Returns:
+//   - *structInfo: The mapped fields of the struct type.
+func structInfoOf(typ reflect.Type) *structInfo {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if cached, ok := structInfoCache.Load(typ); ok {
+		return cached.(*structInfo)
+	}
+
+	info := &structInfo{
+		fields: collectStructFields(typ, nil, "", false),
+		byName: make(map[string]int),
+	}
+
+	for i, field := range info.fields {
+		if _, exists := info.byName[field.Name]; !exists {
+			info.byName[field.Name] = i
+		}
+	}
+
+	cached, _ := structInfoCache.LoadOrStore(typ, info)
+
+	return cached.(*structInfo)
+}
+
+// collectStructFields walks the fields of a struct type recursively.
+//
+// Parameters:
+//   - typ (reflect.Type): The struct type to walk.
+//   - index ([]int): The index sequence of the struct inside the root struct.
+//   - prefix (string): The column prefix of a nested struct, e.g. "user.".
+//   - nested (bool): Whether the struct is a tagged nested struct.
+//
+// Returns:
+//   - []structField: The mapped fields of the struct and its embedded or nested structs.
+func collectStructFields(typ reflect.Type, index []int, prefix string, nested bool) []structField {
+	var fields []structField
+
+	if typ.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tag, hasTag := field.Tag.Lookup(structTag)
+		name, _, _ := strings.Cut(tag, ",")
+
+		if name == "-" {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		// Promote the fields of embedded structs without a db tag.
+		if field.Anonymous && !hasTag {
+			fields = append(fields, collectStructFields(fieldType, fieldIndex, prefix, nested)...)
+
+			continue
+		}
+
+		if !field.IsExported() || name == "" {
+			continue
+		}
+
+		// Map the fields of a tagged nested struct with the tag as prefix.
+		if fieldType.Kind() == reflect.Struct && !isValueType(fieldType) {
+			fields = append(fields, collectStructFields(fieldType, fieldIndex, prefix+name+".", true)...)
+
+			continue
+		}
+
+		fields = append(fields, structField{
+			Name:   prefix + name,
+			Index:  fieldIndex,
+			Nested: nested,
+		})
+	}
+
+	return fields
+}
+
+// isValueType reports whether a struct type is stored in a single column,
+// such as time.Time, sql.NullString or any type implementing sql.Scanner or driver.Valuer.
+//
+// Parameters:
+//   - typ (reflect.Type): The struct type.
+//
+// Returns:
+//   - bool: true if the struct is a column value.
+func isValueType(typ reflect.Type) bool {
+	return typ == timeType ||
+		typ.Implements(scannerType) || reflect.PointerTo(typ).Implements(scannerType) ||
+		typ.Implements(valuerType) || reflect.PointerTo(typ).Implements(valuerType)
+}
+
+// structValue dereferences pointers until it reaches a struct value.
+//
+// Parameters:
+//   - v (any): A struct or a pointer to a struct.
+//
+// Returns:
+//   - reflect.Value: The struct value.
+//   - bool: false if v is not a struct or is a nil pointer.
+func structValue(v any) (reflect.Value, bool) {
+	value := reflect.ValueOf(v)
+
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return value, false
+		}
+
+		value = value.Elem()
+	}
+
+	return value, value.Kind() == reflect.Struct
+}
+
+// fieldValue returns the value of a mapped field.
+// A nil embedded struct pointer results in an invalid value.
+//
+// Parameters:
+//   - value (reflect.Value): The root struct value.
+//   - index ([]int): The index sequence of the field.
+//
+// Returns:
+//   - reflect.Value: The field value, or the zero Value if an embedded pointer is nil.
+func fieldValue(value reflect.Value, index []int) reflect.Value {
+	field, err := value.FieldByIndexErr(index)
+	if err != nil {
+		return reflect.Value{}
+	}
+
+	return field
+}
+
+// behindPointer reports whether the index sequence of a field goes through a struct pointer,
+// e.g. the fields of a joined table mapped onto a *Company field.
+//
+// Parameters:
+//   - typ (reflect.Type): The root struct type.
+//   - index ([]int): The index sequence of the field.
+//
+// Returns:
+//   - bool: true if a struct pointer sits on the path of the field.
+func behindPointer(typ reflect.Type, index []int) bool {
+	for _, fieldIndex := range index[:len(index)-1] {
+		typ = typ.Field(fieldIndex).Type
+
+		if typ.Kind() == reflect.Pointer {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldValueAlloc returns the value of a mapped field, allocating the nil struct pointers on its path.
+//
+// Parameters:
+//   - value (reflect.Value): The addressable root struct value.
+//   - index ([]int): The index sequence of the field.
+//
+// Returns:
+//   - reflect.Value: The addressable field value.
+func fieldValueAlloc(value reflect.Value, index []int) reflect.Value {
+	for i, fieldIndex := range index {
+		if i > 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+
+			value = value.Elem()
+		}
+
+		value = value.Field(fieldIndex)
+	}
+
+	return value
+}
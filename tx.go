@@ -0,0 +1,268 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// ====================================================================
+//                   Transaction :: Structure
+// ====================================================================
+
+// TxBeginner starts transactions. It is satisfied by *sql.DB and *sql.Conn.
+type TxBeginner interface {
+	// BeginTx starts a transaction.
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// TxOptions defines the options of InTx.
+type TxOptions struct {
+	// Isolation is the isolation level of the transaction, the default level of the database if zero.
+	Isolation sql.IsolationLevel
+	// ReadOnly starts a read-only transaction.
+	ReadOnly bool
+	// MaxRetries is the number of retries after a serialization failure, no retry if zero.
+	MaxRetries int
+	// Backoff returns the delay before a retry, starting at attempt 1. DefaultTxBackoff if nil.
+	Backoff func(attempt int) time.Duration
+	// Retryable reports whether an error is a serialization failure. IsSerializationFailure if nil.
+	Retryable func(err error) bool
+}
+
+// defaultTxOptions are the options of InTx when none are given.
+var defaultTxOptions = TxOptions{MaxRetries: 3}
+
+// savepointCounter numbers the savepoints of nested InTx calls.
+var savepointCounter atomic.Uint64
+
+// ====================================================================
+//                   Transaction :: Operators
+// ====================================================================
+
+// InTx runs fn in a transaction. The transaction is committed when fn returns nil, and rolled back
+// when fn returns an error or panics. A transaction which fails with a serialization failure is
+// retried up to opts.MaxRetries times.
+//
+// An Executor is unwrapped, fn receives the transaction wrapped with the hooks, statement cache and scopes of the executor.
+// When db is already a transaction (*sql.Tx), e.g. the Runner given to fn, the call is nested:
+// fn runs inside a SAVEPOINT which is released on success and rolled back to on failure. Nested
+// calls are not retried, the serialization failure is returned to the outermost call.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the transaction.
+//   - db (Runner): A *sql.DB or *sql.Conn to start a transaction, a *sql.Tx to nest in, or an Executor of them.
+//   - opts (*TxOptions): The options of the transaction, 3 retries with DefaultTxBackoff if nil.
+//   - fn (func(tx Runner) error): The function running the statements on tx.
+//
+// Returns:
+//   - error: The error of fn, or of BEGIN, COMMIT or ROLLBACK.
+//
+// Example:
+//
+//	err := fluentsql.InTx(ctx, db, nil, func(tx fluentsql.Runner) error {
+//	    if _, err := debit.ExecContext(ctx, tx); err != nil {
+//	        return err
+//	    }
+//	    _, err := credit.ExecContext(ctx, tx)
+//	    return err
+//	})
+func InTx(ctx context.Context, db Runner, opts *TxOptions, fn func(tx Runner) error) error {
+	// Keep the hooks and the statement cache of an executor on the transaction
+	if executor, ok := db.(*Executor); ok {
+		return InTx(ctx, executor.runner, opts, func(tx Runner) error {
+			return fn(&Executor{runner: tx, hooks: executor.hooks, cache: executor.cache, scopes: executor.scopes})
+		})
+	}
+
+	if tx, ok := db.(*sql.Tx); ok {
+		return inSavepoint(ctx, tx, fn)
+	}
+
+	beginner, ok := db.(TxBeginner)
+	if !ok {
+		return fmt.Errorf("fluentsql: InTx expects a *sql.DB, *sql.Conn, *sql.Tx or *Executor, got %T", db)
+	}
+
+	opt := defaultTxOptions
+	if opts != nil {
+		opt = *opts
+	}
+
+	if opt.Backoff == nil {
+		opt.Backoff = DefaultTxBackoff
+	}
+
+	if opt.Retryable == nil {
+		opt.Retryable = IsSerializationFailure
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := runTx(ctx, beginner, &sql.TxOptions{Isolation: opt.Isolation, ReadOnly: opt.ReadOnly}, fn)
+		if err == nil || attempt >= opt.MaxRetries || !opt.Retryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(opt.Backoff(attempt + 1)):
+		}
+	}
+}
+
+// DefaultTxBackoff is the default delay of InTx retries: 10ms doubled at each attempt, at most 1s.
+//
+// Parameters:
+//   - attempt (int): The retry number, starting at 1.
+//
+// Returns:
+//   - time.Duration: The delay before the retry.
+func DefaultTxBackoff(attempt int) time.Duration {
+	delay := 10 * time.Millisecond << min(attempt-1, 7)
+
+	return min(delay, time.Second)
+}
+
+// IsSerializationFailure reports whether an error is a serialization failure or a deadlock,
+// i.e. the SQLSTATE 40001 or 40P01 of a driver error which has a SQLState() string method,
+// or the MySQL error 1213 (deadlock) or 1205 (lock wait timeout), see mysqlErrorNumber.
+//
+// Parameters:
+//   - err (error): The error.
+//
+// Returns:
+//   - bool: True if the transaction can be retried.
+func IsSerializationFailure(err error) bool {
+	var sqlStateErr interface{ SQLState() string }
+	if errors.As(err, &sqlStateErr) {
+		switch sqlStateErr.SQLState() {
+		case "40001", "40P01":
+			return true
+		}
+	}
+
+	switch number, _ := mysqlErrorNumber(err); number {
+	case 1213, 1205:
+		return true
+	}
+
+	return false
+}
+
+// mysqlErrorNumber returns the error number of a MySQL driver error: the result of a Number() uint16
+// method, or the Number uint16 field of a struct error such as the *MySQLError of go-sql-driver/mysql,
+// which has no method to read it.
+//
+// Parameters:
+//   - err (error): The error.
+//
+// Returns:
+//   - uint16: The error number, 0 if there is none.
+//   - bool: True if a MySQL error was found in the chain of err.
+func mysqlErrorNumber(err error) (uint16, bool) {
+	var numberErr interface{ Number() uint16 }
+	if errors.As(err, &numberErr) {
+		return numberErr.Number(), true
+	}
+
+	for ; err != nil; err = errors.Unwrap(err) {
+		value := reflect.ValueOf(err)
+		if value.Kind() == reflect.Pointer {
+			value = value.Elem()
+		}
+
+		if value.Kind() != reflect.Struct {
+			continue
+		}
+
+		if number := value.FieldByName("Number"); number.IsValid() && number.Kind() == reflect.Uint16 {
+			return uint16(number.Uint()), true
+		}
+	}
+
+	return 0, false
+}
+
+// runTx runs fn in one transaction.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the transaction.
+//   - beginner (TxBeginner): The database or connection.
+//   - txOptions (*sql.TxOptions): The isolation level and read-only flag.
+//   - fn (func(tx Runner) error): The function running the statements.
+//
+// Returns:
+//   - error: The error of fn, or of BEGIN, COMMIT or ROLLBACK.
+func runTx(ctx context.Context, beginner TxBeginner, txOptions *sql.TxOptions, fn func(tx Runner) error) (err error) {
+	tx, err := beginner.BeginTx(ctx, txOptions)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// inSavepoint runs fn inside a savepoint of a transaction.
+// MySQL, PostgreSQL and SQLite share the SAVEPOINT, RELEASE SAVEPOINT and ROLLBACK TO SAVEPOINT syntax.
+//
+// Parameters:
+//   - ctx (context.Context): The context of the statements.
+//   - tx (*sql.Tx): The transaction.
+//   - fn (func(tx Runner) error): The function running the statements.
+//
+// Returns:
+//   - error: The error of fn, or of the savepoint statements.
+func inSavepoint(ctx context.Context, tx *sql.Tx, fn func(tx Runner) error) (err error) {
+	name := fmt.Sprintf("fluentsql_sp_%d", savepointCounter.Add(1))
+
+	if _, err = tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return wrapError("SAVEPOINT "+name, err)
+	}
+
+	rollback := func() error {
+		sqlStr := "ROLLBACK TO SAVEPOINT " + name
+		_, rollbackErr := tx.ExecContext(ctx, sqlStr)
+
+		return wrapError(sqlStr, rollbackErr)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		if rollbackErr := rollback(); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+
+		return err
+	}
+
+	sqlStr := "RELEASE SAVEPOINT " + name
+	_, err = tx.ExecContext(ctx, sqlStr)
+
+	return wrapError(sqlStr, err)
+}
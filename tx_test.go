@@ -0,0 +1,175 @@
+package fluentsql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sqlStateError is a driver error with a SQLSTATE code.
+type sqlStateError string
+
+func (e sqlStateError) Error() string {
+	return "sqlstate " + string(e)
+}
+
+func (e sqlStateError) SQLState() string {
+	return string(e)
+}
+
+// mysqlError is a driver error with a MySQL error number, as the *MySQLError of go-sql-driver/mysql.
+type mysqlError struct {
+	Number  uint16
+	Message string
+}
+
+func (e *mysqlError) Error() string {
+	return e.Message
+}
+
+// TestInTx
+func TestInTx(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	ctx := context.Background()
+	update := UpdateInstance().
+		Update("accounts").
+		Set("balance", 100).
+		Where("id", Eq, 1)
+
+	err := InTx(ctx, db, nil, func(tx Runner) error {
+		if _, err := update.ExecContext(ctx, tx); err != nil {
+			return err
+		}
+
+		// Nested call, the error is rolled back to the savepoint
+		nestedErr := InTx(ctx, tx, nil, func(tx Runner) error {
+			_, _ = update.ExecContext(ctx, tx)
+
+			return errors.New("nested failure")
+		})
+		if nestedErr == nil {
+			t.Fatalf(`Nested InTx must fail`)
+		}
+
+		return InTx(ctx, tx, nil, func(tx Runner) error {
+			_, err := update.ExecContext(ctx, tx)
+
+			return err
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var statements []string
+	for _, query := range fake.Queries() {
+		if query != "UPDATE accounts SET balance = $1 WHERE id = $2" {
+			statements = append(statements, query)
+		}
+	}
+
+	if len(statements) != 6 {
+		t.Fatalf(`Queries %v`, fake.Queries())
+	}
+
+	first := strings.TrimPrefix(statements[1], "SAVEPOINT ")
+	second := strings.TrimPrefix(statements[3], "SAVEPOINT ")
+	expected := []string{
+		"BEGIN",
+		"SAVEPOINT " + first,
+		"ROLLBACK TO SAVEPOINT " + first,
+		"SAVEPOINT " + second,
+		"RELEASE SAVEPOINT " + second,
+		"COMMIT",
+	}
+
+	if first == second || !reflect.DeepEqual(statements, expected) || len(fake.Queries()) != 9 {
+		t.Fatalf(`Queries %v`, fake.Queries())
+	}
+}
+
+// TestInTxRollback
+func TestInTxRollback(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	ctx := context.Background()
+	failure := errors.New("failure")
+
+	if err := InTx(ctx, db, nil, func(tx Runner) error { return failure }); !errors.Is(err, failure) {
+		t.Fatalf(`Error %v != %v`, err, failure)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf(`InTx must propagate the panic`)
+			}
+		}()
+
+		_ = InTx(ctx, db, nil, func(tx Runner) error { panic("boom") })
+	}()
+
+	expected := []string{"BEGIN", "ROLLBACK", "BEGIN", "ROLLBACK"}
+	if !reflect.DeepEqual(fake.Queries(), expected) {
+		t.Fatalf(`Queries %v != %v`, fake.Queries(), expected)
+	}
+}
+
+// TestInTxRetry
+func TestInTxRetry(t *testing.T) {
+	attempts := 0
+
+	db, fake := newFakeDB(t, func(query string, _ []any) fakeResult {
+		if query == "COMMIT" {
+			attempts++
+
+			if attempts < 3 {
+				return fakeResult{Err: sqlStateError("40001")}
+			}
+		}
+
+		return fakeResult{}
+	})
+
+	ctx := context.Background()
+	opts := &TxOptions{
+		MaxRetries: 2,
+		Backoff:    func(int) time.Duration { return time.Millisecond },
+	}
+
+	if err := InTx(ctx, db, opts, func(tx Runner) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts != 3 || len(fake.Queries()) != 6 {
+		t.Fatalf(`Attempts %d, queries %v`, attempts, fake.Queries())
+	}
+
+	attempts = -10
+
+	err := InTx(ctx, db, &TxOptions{MaxRetries: 1}, func(tx Runner) error { return nil })
+	if !IsSerializationFailure(err) || attempts != -8 {
+		t.Fatalf(`Error %v, attempts %d`, err, attempts)
+	}
+
+	if IsSerializationFailure(sqlStateError("23505")) || IsSerializationFailure(errors.New("40001")) {
+		t.Fatalf(`Only SQLSTATE 40001 and 40P01 are serialization failures`)
+	}
+
+	deadlock := &QueryError{SQL: "UPDATE", Err: &mysqlError{Number: 1213, Message: "Deadlock found"}}
+	if !IsSerializationFailure(deadlock) || !IsSerializationFailure(&mysqlError{Number: 1205}) {
+		t.Fatalf(`MySQL errors 1213 and 1205 are serialization failures`)
+	}
+
+	if IsSerializationFailure(&mysqlError{Number: 1062, Message: "Duplicate entry"}) {
+		t.Fatalf(`Only MySQL errors 1213 and 1205 are serialization failures`)
+	}
+
+	if DefaultTxBackoff(1) != 10*time.Millisecond || DefaultTxBackoff(3) != 40*time.Millisecond || DefaultTxBackoff(20) != time.Second {
+		t.Fatalf(`Backoff %v %v %v`, DefaultTxBackoff(1), DefaultTxBackoff(3), DefaultTxBackoff(20))
+	}
+}
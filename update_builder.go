@@ -1,6 +1,11 @@
 package fluentsql
 
-import "strings"
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+)
 
 // ====================================================================
 //                   Update Builder :: Structure
@@ -10,7 +15,9 @@ import "strings"
 //
 // UPDATE [LOW_PRIORITY] [IGNORE] table_reference
 //
+//	[{INNER | CROSS | LEFT} JOIN table_reference [ON join_condition]] ...
 //	SET assignment_list
+//	[FROM from_item [, ...]]
 //	[WHERE where_condition]
 //	[ORDER BY ...]
 //	[LIMIT row_count]
@@ -31,12 +38,30 @@ type UpdateBuilder struct {
 	updateStatement Update
 	// setStatement represents the SET clause of the SQL statement.
 	setStatement UpdateSet
+	// fromStatement represents the FROM clause of the SQL statement (PostgreSQL, SQLite).
+	fromStatement From
+	// joinStatement represents the JOIN clauses of the SQL statement.
+	joinStatement Join
 	// whereStatement represents the WHERE clause of the SQL statement.
 	whereStatement Where
 	// orderByStatement represents the ORDER BY clause of the SQL statement.
 	orderByStatement OrderBy
 	// limitStatement represents the LIMIT clause of the SQL statement.
 	limitStatement Limit
+	// allRows allows the UPDATE statement without WHERE clause.
+	allRows bool
+	// unscoped disables the scopes of the executed statement, see Unscoped.
+	unscoped bool
+	// err keeps the first error of the builder methods, it is returned by Sql.
+	err error
+}
+
+// StructOptions configures which struct fields are turned into SET items by SetStruct.
+type StructOptions struct {
+	// SkipZero ignores the fields holding the zero value of their type.
+	SkipZero bool
+	// Columns limits the SET items to the listed columns. All mapped columns are used when empty.
+	Columns []string
 }
 
 // UpdateInstance Update Builder constructor
@@ -49,6 +74,32 @@ func UpdateInstance() *UpdateBuilder {
 	return &UpdateBuilder{}
 }
 
+// Clone returns a deep copy of the UpdateBuilder instance, including the assignments, joins and conditions.
+//
+// Returns:
+// - *UpdateBuilder: A pointer to a new UpdateBuilder instance.
+func (ub *UpdateBuilder) Clone() *UpdateBuilder {
+	if ub == nil {
+		return nil
+	}
+
+	return &UpdateBuilder{
+		updateStatement: Update{
+			Table: cloneValue(ub.updateStatement.Table),
+			Alias: ub.updateStatement.Alias,
+		},
+		setStatement:     ub.setStatement.clone(),
+		fromStatement:    ub.fromStatement.clone(),
+		joinStatement:    ub.joinStatement.clone(),
+		whereStatement:   ub.whereStatement.clone(),
+		orderByStatement: ub.orderByStatement.clone(),
+		limitStatement:   ub.limitStatement,
+		allRows:          ub.allRows,
+		unscoped:         ub.unscoped,
+		err:              ub.err,
+	}
+}
+
 // ====================================================================
 //                   Update Builder :: Operators
 // ====================================================================
@@ -57,37 +108,78 @@ func UpdateInstance() *UpdateBuilder {
 // Returns:
 // - A string containing the complete SQL query.
 func (ub *UpdateBuilder) String() string {
-	var queryParts []string // Holds different parts of the SQL query.
+	return inlineString(ub)
+}
 
-	// Add UPDATE clause to the query parts.
-	// Add SET clause to the query parts.
-	queryParts = append(queryParts,
-		ub.updateStatement.String(),
-		ub.setStatement.String(),
-	)
+// UpdateClause returns the UPDATE clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Update: The clause, modifying it modifies the builder.
+func (ub *UpdateBuilder) UpdateClause() *Update {
+	return &ub.updateStatement
+}
 
-	// Add WHERE clause if available.
-	whereSql := ub.whereStatement.String()
-	if whereSql != "" {
-		queryParts = append(queryParts, whereSql)
-	}
+// SetClause returns the SET clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *UpdateSet: The clause, modifying it modifies the builder.
+func (ub *UpdateBuilder) SetClause() *UpdateSet {
+	return &ub.setStatement
+}
 
-	// Add ORDER BY clause if available.
-	orderBySql := ub.orderByStatement.String()
-	if orderBySql != "" {
-		queryParts = append(queryParts, orderBySql)
-	}
+// FromClause returns the FROM clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *From: The clause, modifying it modifies the builder.
+func (ub *UpdateBuilder) FromClause() *From {
+	return &ub.fromStatement
+}
 
-	// Add LIMIT clause if available.
-	limitSql := ub.limitStatement.String()
-	if limitSql != "" {
-		queryParts = append(queryParts, limitSql)
-	}
+// JoinClause returns the JOIN clauses of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Join: The clause, modifying it modifies the builder.
+func (ub *UpdateBuilder) JoinClause() *Join {
+	return &ub.joinStatement
+}
 
-	// Join all SQL parts into a single SQL query string.
-	sql := strings.Join(queryParts, " ")
+// WhereClause returns the WHERE clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Where: The clause, modifying it modifies the builder.
+func (ub *UpdateBuilder) WhereClause() *Where {
+	return &ub.whereStatement
+}
 
-	return sql
+// OrderByClause returns the ORDER BY clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *OrderBy: The clause, modifying it modifies the builder.
+func (ub *UpdateBuilder) OrderByClause() *OrderBy {
+	return &ub.orderByStatement
+}
+
+// LimitClause returns the LIMIT clause of the statement, to be inspected or rewritten in place.
+//
+// Returns:
+// - *Limit: The clause, modifying it modifies the builder.
+func (ub *UpdateBuilder) LimitClause() *Limit {
+	return &ub.limitStatement
+}
+
+// fromList collects the FROM table and the joined tables as entries of the UPDATE ... FROM list.
+// Returns:
+// - []fromItem: The FROM list entries.
+// - []Condition: The join conditions to be prepended to the WHERE clause.
+// - error: ErrOuterJoin if an outer join has no preceding entry.
+func (ub *UpdateBuilder) fromList() ([]fromItem, []Condition, error) {
+	var items []fromItem
+
+	if ub.fromStatement.Table != nil {
+		items = append(items, fromItem{From: ub.fromStatement})
+	}
+
+	return ub.joinStatement.asFromList(items)
 }
 
 // Update sets the table and optional alias for the UPDATE clause.
@@ -107,6 +199,43 @@ func (ub *UpdateBuilder) Update(table any, alias ...string) *UpdateBuilder {
 	return ub
 }
 
+// From sets the table or subquery the target table is updated from.
+// PostgreSQL and SQLite render UPDATE ... SET ... FROM table, MySQL renders UPDATE ... CROSS JOIN table SET ...
+// Parameters:
+// - table (any): The table name or a *QueryBuilder subquery.
+// - alias (...string): An optional alias for the table.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) From(table any, alias ...string) *UpdateBuilder {
+	ub.fromStatement.Table = table
+
+	// Table alias
+	if len(alias) > 0 {
+		ub.fromStatement.Alias = alias[0]
+	}
+
+	return ub
+}
+
+// Join adds a join clause to the UPDATE statement.
+// MySQL renders UPDATE a JOIN b ON ... SET, PostgreSQL and SQLite render UPDATE a SET ... FROM b WHERE ...
+// where inner join conditions are moved to the WHERE clause.
+// Parameters:
+// - join (JoinType): The type of join (e.g., INNER JOIN, LEFT JOIN).
+// - table (string): The table to join.
+// - condition (Condition): The ON condition for the join.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) Join(join JoinType, table string, condition Condition) *UpdateBuilder {
+	ub.joinStatement.Append(JoinItem{
+		Join:      join,
+		Table:     table,
+		Condition: condition,
+	})
+
+	return ub
+}
+
 // Set adds a key-value pair to the SET clause.
 // Parameters:
 // - field (any): The column to be updated.
@@ -119,6 +248,242 @@ func (ub *UpdateBuilder) Set(field, value any) *UpdateBuilder {
 	return ub
 }
 
+// Incr increments a column by the given amount: SET field = field + amount.
+// Parameters:
+// - field (string): The column to be incremented.
+// - amount (any): The amount to add.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) Incr(field string, amount any) *UpdateBuilder {
+	return ub.SetExpr(field, Expr(field+" + ?", amount))
+}
+
+// Decr decrements a column by the given amount: SET field = field - amount.
+// Parameters:
+// - field (string): The column to be decremented.
+// - amount (any): The amount to subtract.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) Decr(field string, amount any) *UpdateBuilder {
+	return ub.SetExpr(field, Expr(field+" - ?", amount))
+}
+
+// SetExpr assigns a SQL expression to a column, the arguments of the expression are bound.
+// Parameters:
+// - field (string): The column to be updated.
+// - expression (Expression): The expression, e.g. Expr("price * ?", qty).
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) SetExpr(field string, expression Expression) *UpdateBuilder {
+	ub.setStatement.Append(field, expression)
+
+	return ub
+}
+
+// SetNull assigns NULL to a column: SET field = NULL.
+// Parameters:
+// - field (string): The column to be updated.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) SetNull(field string) *UpdateBuilder {
+	ub.setStatement.Append(field, nil)
+
+	return ub
+}
+
+// SetDefault assigns the column default value: SET field = DEFAULT.
+// Parameters:
+// - field (string): The column to be updated.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) SetDefault(field string) *UpdateBuilder {
+	ub.setStatement.Append(field, ValueDefault)
+
+	return ub
+}
+
+// SetCaseByKey updates many rows in one statement, each with its own value.
+// It assigns a simple CASE expression on the key column to the field and limits the rows with
+// WHERE key IN (...), the conditions added before are grouped if they contain OR. The map entries
+// are ordered by key.
+// Parameters:
+// - field (string): The column to be updated.
+// - key (string): The key column identifying the rows, e.g. "id".
+// - values (any): A map from key values to the new field values, e.g. map[int]string.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+//
+// Example:
+//
+//	UpdateInstance().Update("orders").SetCaseByKey("status", "id", map[int]string{1: "paid", 2: "shipped"})
+//	// UPDATE orders SET status = CASE id WHEN $1 THEN $2 WHEN $3 THEN $4 END WHERE id IN ($5, $6)
+func (ub *UpdateBuilder) SetCaseByKey(field, key string, values any) *UpdateBuilder {
+	mapValue := reflect.ValueOf(values)
+	if mapValue.Kind() != reflect.Map || mapValue.Len() == 0 {
+		ub.setError(fmt.Errorf("fluentsql: SetCaseByKey expects a non-empty map, got %T", values))
+
+		return ub
+	}
+
+	keys := sortedMapKeys(mapValue)
+
+	caseValue := FieldCase(key, "")
+	keyValues := make([]any, 0, len(keys))
+
+	for _, mapKey := range keys {
+		caseValue.When(mapKey.Interface(), mapValue.MapIndex(mapKey).Interface())
+		keyValues = append(keyValues, mapKey.Interface())
+	}
+
+	ub.setStatement.Append(field, caseValue)
+
+	// The rows of the CASE are required, whatever the OR conditions already added
+	ub.whereStatement.Conditions = grouped(ub.whereStatement.Conditions)
+
+	return ub.Where(key, In, keyValues)
+}
+
+// SetStruct adds a SET item for each db-tagged field of a struct, the fields of nested structs are skipped.
+// Parameters:
+// - v (any): A struct or a pointer to a struct, its fields are mapped by the `db:"column"` tag.
+// - opts (...StructOptions): Optional settings to skip zero values or to limit the columns.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int    `db:"id"`
+//	    Name  string `db:"name"`
+//	    Email string `db:"email"`
+//	}
+//
+//	UpdateInstance().Update("users").SetStruct(user, StructOptions{Columns: []string{"name", "email"}})
+//	// UPDATE users SET name = $1, email = $2
+func (ub *UpdateBuilder) SetStruct(v any, opts ...StructOptions) *UpdateBuilder {
+	var opt StructOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	value, ok := structValue(v)
+	if !ok {
+		ub.setError(fmt.Errorf("%w: SetStruct(%T)", ErrNotStruct, v))
+
+		return ub
+	}
+
+	for _, field := range structFields(value.Type()) {
+		if field.Nested {
+			continue
+		}
+
+		if len(opt.Columns) > 0 && !slices.Contains(opt.Columns, field.Name) {
+			continue
+		}
+
+		fieldVal := fieldValue(value, field.Index)
+		if !fieldVal.IsValid() || (opt.SkipZero && fieldVal.IsZero()) {
+			continue
+		}
+
+		ub.setStatement.Append(field.Name, fieldVal.Interface())
+	}
+
+	return ub
+}
+
+// SetMap adds a SET item for each entry of a map, ordered by column name.
+// Parameters:
+// - values (map[string]any): The new values by column name.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) SetMap(values map[string]any) *UpdateBuilder {
+	columns := make([]string, 0, len(values))
+	for column := range values {
+		columns = append(columns, column)
+	}
+
+	sort.Strings(columns)
+
+	for _, column := range columns {
+		ub.setStatement.Append(column, values[column])
+	}
+
+	return ub
+}
+
+// SetChanged compares two values of the same struct type and adds a SET item
+// with the new value for each db-tagged field which differs.
+// Sql returns ErrNoChanges when no field has changed.
+// Parameters:
+// - oldValue (any): The struct, or pointer to struct, as loaded from the database.
+// - newValue (any): The modified struct, or pointer to struct.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) SetChanged(oldValue, newValue any) *UpdateBuilder {
+	oldStruct, okOld := structValue(oldValue)
+	newStruct, okNew := structValue(newValue)
+
+	if !okOld || !okNew {
+		ub.setError(fmt.Errorf("%w: SetChanged(%T, %T)", ErrNotStruct, oldValue, newValue))
+
+		return ub
+	}
+
+	if oldStruct.Type() != newStruct.Type() {
+		ub.setError(fmt.Errorf("fluentsql: SetChanged compares different types %s and %s", oldStruct.Type(), newStruct.Type()))
+
+		return ub
+	}
+
+	changed := 0
+
+	for _, field := range structFields(newStruct.Type()) {
+		if field.Nested {
+			continue
+		}
+
+		oldField := fieldValue(oldStruct, field.Index)
+		newField := fieldValue(newStruct, field.Index)
+
+		if !newField.IsValid() {
+			continue
+		}
+
+		if oldField.IsValid() && reflect.DeepEqual(oldField.Interface(), newField.Interface()) {
+			continue
+		}
+
+		ub.setStatement.Append(field.Name, newField.Interface())
+		changed++
+	}
+
+	if changed == 0 {
+		ub.setError(ErrNoChanges)
+	}
+
+	return ub
+}
+
+// AllRows allows the UPDATE statement without WHERE clause, Sql returns ErrMissingWhere otherwise.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) AllRows() *UpdateBuilder {
+	ub.allRows = true
+
+	return ub
+}
+
+// setError keeps the first error of the builder methods.
+// Parameters:
+// - err (error): The error to keep.
+func (ub *UpdateBuilder) setError(err error) {
+	if ub.err == nil {
+		ub.err = err
+	}
+}
+
 // Where adds a condition to the WHERE clause using an AND operator.
 // Parameters:
 // - field (any): The field or column to evaluate.
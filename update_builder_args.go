@@ -1,52 +1,109 @@
 package fluentsql
 
-import (
-	"fmt"
-	"strings"
-)
-
 // Sql generates the SQL query string and its corresponding arguments.
-func (ub *UpdateBuilder) Sql() (string, []any, interface{}) {
+func (ub *UpdateBuilder) Sql() (string, []any, error) {
 	return ub.StringArgs()
 }
 
 // StringArgs constructs the SQL query string and collects the argument values.
 // Returns the SQL query string, the list of arguments, and an error if any occurred.
+// An error is returned when a builder method failed (e.g. SetStruct with a non-struct value),
+// when the SET clause has no items, or when the WHERE clause is empty unless AllRows was called.
 func (ub *UpdateBuilder) StringArgs() (string, []any, error) {
-	var queryParts []string // Holds different parts of the SQL query.
-	var sql string          // The final SQL query string.
-	var args []any          // A slice of arguments to be used in the query.
+	if err := ub.check(); err != nil {
+		return "", nil, err
+	}
+
+	r := newRenderer(nil)
+	ub.render(r)
+
+	return r.finishStatement()
+}
+
+// check returns the error of the builder methods, ErrEmptySet, ErrOuterJoin, or ErrMissingWhere unless
+// AllRows was called.
+// Returns:
+// - error: The first failed guard, nil if the statement can be generated.
+func (ub *UpdateBuilder) check() error {
+	if ub.err != nil {
+		return ub.err
+	}
+
+	if len(ub.setStatement.Items) == 0 {
+		return ErrEmptySet
+	}
+
+	// MySQL joins the target table, the other dialects attach the outer joins to the FROM list.
+	if !IsDialect(MySQL) {
+		if _, _, err := ub.fromList(); err != nil {
+			return err
+		}
+	}
+
+	// Guard against updating all rows by mistake.
+	if !ub.allRows {
+		return checkWhere("UPDATE", ub.updateStatement.Table, ub.whereStatement, ub.joinStatement)
+	}
+
+	return nil
+}
 
+// render writes the UPDATE statement without checking the guards, e.g. for String().
+// Parameters:
+// - r: The render context.
+func (ub *UpdateBuilder) render(r *renderer) {
 	// Add UPDATE statement.
-	sql, args = ub.updateStatement.StringArgs(args)
-	queryParts = append(queryParts, sql)
+	ub.updateStatement.render(r)
+
+	whereStatement := ub.whereStatement
+
+	if IsDialect(MySQL) {
+		// MySQL joins the other tables before SET, so their arguments come first.
+		if ub.fromStatement.Table != nil {
+			r.write(" CROSS JOIN ")
+			ub.fromStatement.renderTable(r)
+		}
 
-	// Add SET statement.
-	sql, args = ub.setStatement.StringArgs(args)
-	queryParts = append(queryParts, sql)
+		if len(ub.joinStatement.Items) > 0 {
+			r.writeByte(' ')
+			ub.joinStatement.render(r)
+		}
+
+		// Add SET statement.
+		r.writeByte(' ')
+		ub.setStatement.render(r)
+	} else {
+		// Add SET statement.
+		r.writeByte(' ')
+		ub.setStatement.render(r)
+
+		// PostgreSQL and SQLite list the other tables after SET, so their arguments follow the SET arguments.
+		items, conditions, _ := ub.fromList()
+		if len(items) > 0 {
+			r.write(" FROM ")
+			renderFromList(r, items)
+		}
+
+		whereStatement.Conditions = append(conditions, grouped(whereStatement.Conditions)...)
+	}
 
 	// Add WHERE clause if present.
-	sql, args = ub.whereStatement.StringArgs(args)
-	if sql != "" {
-		queryParts = append(queryParts, sql)
+	if len(whereStatement.Conditions) > 0 {
+		r.writeByte(' ')
+		whereStatement.render(r)
 	}
 
 	// Add ORDER BY clause if present.
-	sql, args = ub.orderByStatement.StringArgs(args)
-	if sql != "" {
-		queryParts = append(queryParts, sql)
+	if len(ub.orderByStatement.Items) > 0 {
+		r.writeByte(' ')
+		ub.orderByStatement.render(r)
 	}
 
 	// Add LIMIT clause if present.
-	sql, args = ub.limitStatement.StringArgs(args)
-	if sql != "" {
-		queryParts = append(queryParts, sql)
+	if ub.limitStatement.Limit > 0 || ub.limitStatement.Offset > 0 {
+		r.writeByte(' ')
+		ub.limitStatement.render(r)
 	}
-
-	// Combine all query parts into a single string.
-	sql = strings.Join(queryParts, " ")
-
-	return sql, args, nil
 }
 
 // StringArgs generates the SQL fragment for the UPDATE statement and appends to provided arguments.
@@ -57,15 +114,21 @@ func (ub *UpdateBuilder) StringArgs() (string, []any, error) {
 // - A formatted SQL UPDATE string.
 // - A slice of arguments.
 func (u *Update) StringArgs(args []any) (string, []any) {
-	var sb strings.Builder // Used for efficient string concatenation.
-	sb.WriteString(fmt.Sprintf("UPDATE %s", u.Table))
+	return bindStringArgs(u, args)
+}
+
+// render writes the UPDATE clause with the table and its alias.
+// Parameters:
+// - r: The render context.
+func (u *Update) render(r *renderer) {
+	r.write("UPDATE ")
+	r.writeAny(u.Table)
 
 	// Add table alias if present.
 	if u.Alias != "" {
-		sb.WriteString(" " + u.Alias)
+		r.writeByte(' ')
+		r.write(u.Alias)
 	}
-
-	return sb.String(), args
 }
 
 // StringArgs generates the SQL fragment for the SET clause and appends to provided arguments.
@@ -76,18 +139,22 @@ func (u *Update) StringArgs(args []any) (string, []any) {
 // - A formatted SQL SET string.
 // - A slice of arguments.
 func (s *UpdateSet) StringArgs(args []any) (string, []any) {
-	var setColumns []string // Holds the individual SET assignments.
+	return bindStringArgs(s, args)
+}
 
-	// Process each item in the SET clause.
-	for _, item := range s.Items {
-		var sql string
+// render writes the SET clause, the assignments are separated by commas.
+// Parameters:
+// - r: The render context.
+func (s *UpdateSet) render(r *renderer) {
+	r.write("SET ")
 
-		sql, args = item.StringArgs(args)
+	for i := range s.Items {
+		if i > 0 {
+			r.write(", ")
+		}
 
-		setColumns = append(setColumns, sql)
+		s.Items[i].render(r)
 	}
-
-	return fmt.Sprintf("SET %s", strings.Join(setColumns, ", ")), args
 }
 
 // StringArgs generates the SQL fragment for an individual assignment in the SET clause.
@@ -98,71 +165,40 @@ func (s *UpdateSet) StringArgs(args []any) (string, []any) {
 // - A formatted SQL string for the assignment.
 // - A slice of arguments.
 func (s *UpdateItem) StringArgs(args []any) (string, []any) {
+	return bindStringArgs(s, args)
+}
+
+// render writes an assignment of the SET clause.
+// Parameters:
+// - r: The render context.
+func (s *UpdateItem) render(r *renderer) {
 	// Check if Field is a slice of strings for multi-column updates.
 	// SET (field1, field2,...) = (int, string, ValueField...)
 	// SET (field1, field2,...) = (SELECT * FROM table_name)
 	if fieldStringSlice, ok := s.Field.([]string); ok {
-		fieldStr := joinSlice(fieldStringSlice, ",") // Join field names with commas.
-
-		// If the value is a QueryBuilder, process the associated query.
-		if valueQueryBuilder, ok := s.Value.(*QueryBuilder); ok {
-			var _sql string
-			_sql, args, _ = valueQueryBuilder.StringArgs(args)
-
-			return fmt.Sprintf("(%s) = (%s)", fieldStr, _sql), args
-		}
-
-		// If the value is a slice, process each item in the slice.
-		if fieldAnySlice, ok := s.Value.([]any); ok {
-			var values []string
-			for _, fieldAny := range fieldAnySlice {
-				if valueField, ok := fieldAny.(ValueField); ok { // Value is a ValueField.
-					values = append(values, valueField.String())
-				} else if valueString, ok := fieldAny.(string); ok { // Value is a string.
-					args = append(args, valueString)
-					valueStr := p(args)
-
-					values = append(values, valueStr)
-				} else { // Value is an int or float.
-					args = append(args, fieldAny)
-					valueStr := p(args)
-
-					values = append(values, valueStr)
-				}
-			}
-
-			valueStr := strings.Join(values, ", ")
-
-			return fmt.Sprintf("(%s) = (%v)", fieldStr, valueStr), args
+		switch value := s.Value.(type) {
+		case *QueryBuilder:
+			// If the value is a QueryBuilder, process the associated query.
+			r.writeByte('(')
+			r.writeList(fieldStringSlice)
+			r.write(") = (")
+			value.renderStatement(r)
+			r.writeByte(')')
+		case []any:
+			// If the value is a slice, process each item in the slice.
+			r.writeByte('(')
+			r.writeList(fieldStringSlice)
+			r.write(") = (")
+			r.values(value)
+			r.writeByte(')')
 		}
 
-		return "", args
-	}
-
-	// If the value is a QueryBuilder, process the associated query.
-	if valueQueryBuilder, ok := s.Value.(*QueryBuilder); ok {
-		var _sql string
-		_sql, args, _ = valueQueryBuilder.StringArgs(args)
-
-		return fmt.Sprintf("%s = (%s)", s.Field, _sql), args
-	}
-
-	// If the value is a ValueField, format it as-is.
-	if valueField, ok := s.Value.(ValueField); ok {
-		return fmt.Sprintf("%s = %s", s.Field, valueField), args
+		return
 	}
 
-	// If the value is a string, add it to the arguments and format it.
-	if valueString, ok := s.Value.(string); ok {
-		args = append(args, valueString)
-		valueStr := p(args)
-
-		return fmt.Sprintf("%s = %s", s.Field, valueStr), args
-	}
-
-	// Default fallback for other types (e.g., int, float).
-	args = append(args, s.Value)
-	valueStr := p(args)
-
-	return fmt.Sprintf("%s = %s", s.Field, valueStr), args
+	// NULL, DEFAULT, ValueField and expressions are kept as SQL, subqueries and CASE add their own arguments,
+	// other values are bound as arguments.
+	r.writeAny(s.Field)
+	r.write(" = ")
+	r.value(s.Value)
 }
@@ -1,6 +1,8 @@
 package fluentsql
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -72,17 +74,20 @@ func TestUpdateArgs(t *testing.T) {
 				Select("last_name").
 				From("employees").
 				Where("employee_id", Eq, ValueField("dependents.employee_id")),
-			),
+			).
+			AllRows(),
 		"UPDATE summary s SET (sum_x, sum_y, avg_x, avg_y) = (SELECT sum(x), sum(y), avg(x), avg(y) FROM data d WHERE d.group_id = s.group_id)": UpdateInstance().
 			Update("summary", "s").
 			Set([]string{"sum_x", "sum_y", "avg_x", "avg_y"}, QueryInstance().
 				Select("sum(x)", "sum(y)", "avg(x)", "avg(y)").
 				From("data", "d").
 				Where("d.group_id", Eq, ValueField("s.group_id")),
-			),
+			).
+			AllRows(),
 		"UPDATE summary SET (sum_x, sum_y, avg_x) = ($1, $2, $3)": UpdateInstance().
 			Update("summary").
-			Set([]string{"sum_x", "sum_y", "avg_x"}, []any{1, "One", 34.5}),
+			Set([]string{"sum_x", "sum_y", "avg_x"}, []any{1, "One", 34.5}).
+			AllRows(),
 	}
 
 	for expected, query := range testCases {
@@ -96,3 +101,290 @@ func TestUpdateArgs(t *testing.T) {
 		}
 	}
 }
+
+// TestUpdateFromJoin
+func TestUpdateFromJoin(t *testing.T) {
+	testCases := map[string]*UpdateBuilder{
+		"UPDATE products p SET price = s.price FROM suppliers s WHERE s.product_id = p.id AND s.active = true": UpdateInstance().
+			Update("products", "p").
+			Set("price", ValueField("s.price")).
+			From("suppliers", "s").
+			Where("s.product_id", Eq, ValueField("p.id")).
+			Where("s.active", Eq, true),
+		"UPDATE products p SET price = d.price FROM discounts d LEFT JOIN regions r ON r.id = d.region_id WHERE d.product_id = p.id": UpdateInstance().
+			Update("products", "p").
+			Set("price", ValueField("d.price")).
+			Join(InnerJoin, "discounts d", Condition{
+				Field: "d.product_id",
+				Opt:   Eq,
+				Value: ValueField("p.id"),
+			}).
+			Join(LeftJoin, "regions r", Condition{
+				Field: "r.id",
+				Opt:   Eq,
+				Value: ValueField("d.region_id"),
+			}),
+		"UPDATE products p SET price = d.price FROM discounts d WHERE d.product_id = p.id AND (d.kind = 'sale' OR d.kind = 'clearance')": UpdateInstance().
+			Update("products", "p").
+			Set("price", ValueField("d.price")).
+			Join(InnerJoin, "discounts d", Condition{
+				Field: "d.product_id",
+				Opt:   Eq,
+				Value: ValueField("p.id"),
+			}).
+			Where("d.kind", Eq, "sale").
+			WhereOr("d.kind", Eq, "clearance"),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
+
+// TestUpdateFromJoinArgs
+func TestUpdateFromJoinArgs(t *testing.T) {
+	query := UpdateInstance().
+		Update("products", "p").
+		Set("price", 10).
+		From(QueryInstance().
+			Select("product_id").
+			From("orders").
+			Where("status", Eq, "paid"), "o").
+		Where("o.product_id", Eq, ValueField("p.id"))
+
+	expected := "UPDATE products p SET price = $1 FROM (SELECT product_id FROM orders WHERE status = $2) o WHERE o.product_id = p.id"
+
+	sql, args, _ := query.Sql()
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	if len(args) != 2 || args[0] != 10 || args[1] != "paid" {
+		t.Fatalf(`Args %v`, args)
+	}
+}
+
+// TestUpdateJoinMySQL
+func TestUpdateJoinMySQL(t *testing.T) {
+	SetDialect(new(MySQLDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	query := UpdateInstance().
+		Update("products", "p").
+		Join(InnerJoin, "discounts d", Condition{
+			Field: "d.product_id",
+			Opt:   Eq,
+			Value: ValueField("p.id"),
+		}).
+		From(QueryInstance().
+			Select("product_id").
+			From("orders").
+			Where("status", Eq, "paid"), "o").
+		Set("price", 10).
+		Where("o.product_id", Eq, ValueField("p.id"))
+
+	expected := "UPDATE products p CROSS JOIN (SELECT product_id FROM orders WHERE status = 'paid') o INNER JOIN discounts d ON d.product_id = p.id SET price = 10 WHERE o.product_id = p.id"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	expected = "UPDATE products p CROSS JOIN (SELECT product_id FROM orders WHERE status = ?) o INNER JOIN discounts d ON d.product_id = p.id SET price = ? WHERE o.product_id = p.id"
+
+	sql, args, _ := query.Sql()
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	if len(args) != 2 || args[0] != "paid" || args[1] != 10 {
+		t.Fatalf(`Args %v`, args)
+	}
+}
+
+// TestUpdateOuterJoin
+func TestUpdateOuterJoin(t *testing.T) {
+	query := UpdateInstance().
+		Update("products", "p").
+		Set("price", 10).
+		Join(LeftJoin, "discounts d", Condition{
+			Field: "d.product_id",
+			Opt:   Eq,
+			Value: ValueField("p.id"),
+		}).
+		Where("p.id", Eq, 1)
+
+	// The target table cannot be left joined in UPDATE ... FROM
+	if _, _, err := query.Sql(); !errors.Is(err, ErrOuterJoin) {
+		t.Fatalf(`Error %v != %v`, err, ErrOuterJoin)
+	}
+
+	SetDialect(new(MySQLDialect))
+	defer SetDialect(new(PostgreSQLDialect))
+
+	expected := "UPDATE products p LEFT JOIN discounts d ON d.product_id = p.id SET price = ? WHERE p.id = ?"
+	if sql, args, err := query.Sql(); err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+	}
+}
+
+type testAudit struct {
+	UpdatedBy string `db:"updated_by"`
+}
+
+type testProduct struct {
+	testAudit
+	ID       int     `db:"id"`
+	Name     string  `db:"name"`
+	Price    float64 `db:"price"`
+	Stock    *int    `db:"stock"`
+	Internal string  `db:"-"`
+}
+
+// TestUpdateSetStruct
+func TestUpdateSetStruct(t *testing.T) {
+	product := testProduct{
+		testAudit: testAudit{UpdatedBy: "admin"},
+		ID:        7,
+		Name:      "Pen",
+		Internal:  "skip",
+	}
+
+	testCases := map[string]*UpdateBuilder{
+		"UPDATE products SET updated_by = $1, id = $2, name = $3, price = $4, stock = $5 WHERE id = $6": UpdateInstance().
+			Update("products").
+			SetStruct(product).
+			Where("id", Eq, product.ID),
+		"UPDATE products SET updated_by = $1, id = $2, name = $3 WHERE id = $4": UpdateInstance().
+			Update("products").
+			SetStruct(&product, StructOptions{SkipZero: true}).
+			Where("id", Eq, product.ID),
+		"UPDATE products SET name = $1, price = $2 WHERE id = $3": UpdateInstance().
+			Update("products").
+			SetStruct(product, StructOptions{Columns: []string{"name", "price"}}).
+			Where("id", Eq, product.ID),
+		"UPDATE products SET name = $1, price = $2, stock = $3 WHERE id = $4": UpdateInstance().
+			Update("products").
+			SetMap(map[string]any{"stock": 3, "price": 1.5, "name": "Pen"}).
+			Where("id", Eq, product.ID),
+	}
+
+	for expected, query := range testCases {
+		sql, args, err := query.Sql()
+
+		if err != nil || sql != expected {
+			t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+		}
+	}
+
+	if _, _, err := UpdateInstance().Update("products").SetStruct(1).Sql(); !errors.Is(err, ErrNotStruct) {
+		t.Fatalf(`Error %v != %v`, err, ErrNotStruct)
+	}
+}
+
+// TestUpdateSetChanged
+func TestUpdateSetChanged(t *testing.T) {
+	stock := 3
+	oldProduct := testProduct{ID: 7, Name: "Pen", Price: 1.5}
+	newProduct := oldProduct
+	newProduct.Price = 2
+	newProduct.Stock = &stock
+
+	sql, args, err := UpdateInstance().
+		Update("products").
+		SetChanged(oldProduct, &newProduct).
+		Where("id", Eq, newProduct.ID).
+		Sql()
+
+	expected := "UPDATE products SET price = $1, stock = $2 WHERE id = $3"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+	}
+
+	_, _, err = UpdateInstance().
+		Update("products").
+		SetChanged(oldProduct, oldProduct).
+		Where("id", Eq, oldProduct.ID).
+		Sql()
+
+	if !errors.Is(err, ErrNoChanges) {
+		t.Fatalf(`Error %v != %v`, err, ErrNoChanges)
+	}
+
+	_, _, err = UpdateInstance().
+		Update("products").
+		Where("id", Eq, oldProduct.ID).
+		Sql()
+
+	if !errors.Is(err, ErrEmptySet) {
+		t.Fatalf(`Error %v != %v`, err, ErrEmptySet)
+	}
+}
+
+// TestUpdateExpressions
+func TestUpdateExpressions(t *testing.T) {
+	testCases := map[string]*UpdateBuilder{
+		"UPDATE products SET stock = stock + 1, sold = sold - 1, total = price * 3, note = NULL, status = DEFAULT WHERE id = 7": UpdateInstance().
+			Update("products").
+			Incr("stock", 1).
+			Decr("sold", 1).
+			SetExpr("total", Expr("price * ?", 3)).
+			SetNull("note").
+			SetDefault("status").
+			Where("id", Eq, 7),
+		"UPDATE orders SET status = CASE id WHEN 1 THEN 'paid' WHEN 2 THEN 'shipped' WHEN 10 THEN 'closed' END WHERE id IN (1, 2, 10)": UpdateInstance().
+			Update("orders").
+			SetCaseByKey("status", "id", map[int]string{10: "closed", 2: "shipped", 1: "paid"}),
+		"UPDATE orders SET status = CASE id WHEN 1 THEN 'paid' END WHERE (region = 'eu' OR region = 'uk') AND id IN (1)": UpdateInstance().
+			Update("orders").
+			Where("region", Eq, "eu").
+			WhereOr("region", Eq, "uk").
+			SetCaseByKey("status", "id", map[int]string{1: "paid"}),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
+
+// TestUpdateExpressionsArgs
+func TestUpdateExpressionsArgs(t *testing.T) {
+	testCases := map[string]*UpdateBuilder{
+		"UPDATE products SET stock = stock + $1, total = price * $2, note = NULL, status = DEFAULT WHERE id = $3": UpdateInstance().
+			Update("products").
+			Incr("stock", 1).
+			SetExpr("total", Expr("price * ?", 3)).
+			SetNull("note").
+			SetDefault("status").
+			Where("id", Eq, 7),
+		"UPDATE orders SET status = CASE id WHEN $1 THEN $2 WHEN $3 THEN $4 END WHERE id IN ($5, $6)": UpdateInstance().
+			Update("orders").
+			SetCaseByKey("status", "id", map[string]string{"b": "shipped", "a": "paid"}),
+		"UPDATE employees SET level = CASE  WHEN salary < $1 THEN $2 WHEN salary >= $3 THEN $4 END": UpdateInstance().
+			Update("employees").
+			AllRows().
+			Set("level", FieldCase("", "").
+				When([]Condition{{Field: "salary", Opt: Lesser, Value: 3000}}, "Low").
+				When([]Condition{{Field: "salary", Opt: GrEq, Value: 3000}}, "High")),
+	}
+
+	for expected, query := range testCases {
+		sql, args, err := query.Sql()
+
+		if err != nil || sql != expected {
+			t.Fatalf(`Query %s != %s (%v) %v`, sql, expected, args, err)
+		}
+	}
+
+	_, args, _ := UpdateInstance().
+		Update("orders").
+		SetCaseByKey("status", "id", map[string]string{"b": "shipped", "a": "paid"}).
+		Sql()
+
+	expectedArgs := []any{"a", "paid", "b", "shipped", "a", "b"}
+	if fmt.Sprint(args) != fmt.Sprint(expectedArgs) {
+		t.Fatalf(`Args %v != %v`, args, expectedArgs)
+	}
+}
@@ -2,21 +2,35 @@ package fluentsql
 
 import (
 	"fmt"
-	"strings"
+	"reflect"
+	"sort"
 )
 
-// joinSlice joins a slice of any type into a single string with a specified separator.
+// sortedMapKeys returns the keys of a map ordered by value, numbers are compared numerically
+// and other keys by their string representation.
 //
 // Parameters:
-//   - values: []T - A slice of any type to be joined into a string.
-//   - separator: string - A string to be used as the separator between elements in the slice.
+//   - mapValue: reflect.Value - A map value.
 //
 // Returns:
-//   - string: A string representation of the slice with elements separated by the given separator.
-//
-// Variables:
-//   - values: The input slice whose elements are to be joined.
-//   - separator: The string used to separate the elements in the joined result.
-func joinSlice[T any](values []T, separator string) string {
-	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(values)), fmt.Sprintf("%s ", separator)), "[]")
+//   - []reflect.Value: The ordered keys of the map.
+func sortedMapKeys(mapValue reflect.Value) []reflect.Value {
+	keys := mapValue.MapKeys()
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+
+		switch {
+		case a.CanInt() && b.CanInt():
+			return a.Int() < b.Int()
+		case a.CanUint() && b.CanUint():
+			return a.Uint() < b.Uint()
+		case a.CanFloat() && b.CanFloat():
+			return a.Float() < b.Float()
+		}
+
+		return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+	})
+
+	return keys
 }
@@ -0,0 +1,200 @@
+package fluentsql
+
+// ====================================================================
+//                   Walk :: Structure
+// ====================================================================
+
+// Node is a node of a statement tree visited by Walk. It is one of:
+//   - the builders: *QueryBuilder, *InsertBuilder, *UpdateBuilder, *DeleteBuilder
+//   - the clauses: *Select, *From, *Join, *JoinItem, *Where, *Having, *GroupBy, *OrderBy, *Limit, *Fetch,
+//     *Insert, *InsertRows, *InsertRow, *InsertQuery, *Update, *UpdateSet, *UpdateItem, *Delete
+//   - the conditions: *Condition
+//   - the expressions: Expression, ValueBetween, *Case, *WhenCase
+//
+// The clauses and conditions are given by pointer, so that a visitor can rewrite them in place.
+type Node interface {
+	String() string
+}
+
+// ====================================================================
+//                   Walk :: Operators
+// ====================================================================
+
+// Walk traverses a statement tree in depth-first order. It calls fn for node, then for each of its
+// children if fn returns true. The children are the clauses of a builder, including the empty ones so
+// that they can be filled, the conditions of a clause, and the subqueries, CASE and raw expressions
+// used as columns, tables, fields or values, including the items of []any values such as IN lists.
+// The USING tables of a DELETE statement are visited as *From. Plain values and column names are not
+// visited.
+//
+// Parameters:
+//   - node (Node): The root of the traversal, usually a builder.
+//   - fn (func(Node) bool): The visitor, it returns false to skip the children of a node.
+//
+// Example:
+//
+//	// Check that every query touching orders filters on tenant_id
+//	touchesOrders, filtered := false, false
+//
+//	fluentsql.Walk(query, func(node fluentsql.Node) bool {
+//	    switch n := node.(type) {
+//	    case *fluentsql.From:
+//	        touchesOrders = touchesOrders || n.Table == "orders"
+//	    case *fluentsql.JoinItem:
+//	        touchesOrders = touchesOrders || n.Table == "orders"
+//	    case *fluentsql.Condition:
+//	        filtered = filtered || n.Field == "tenant_id"
+//	    }
+//
+//	    return true
+//	})
+func Walk(node Node, fn func(Node) bool) {
+	if node == nil || !fn(node) {
+		return
+	}
+
+	switch n := node.(type) {
+	case *QueryBuilder:
+		Walk(&n.selectStatement, fn)
+		Walk(&n.fromStatement, fn)
+		Walk(&n.joinStatement, fn)
+		Walk(&n.whereStatement, fn)
+		Walk(&n.groupByStatement, fn)
+		Walk(&n.havingStatement, fn)
+		Walk(&n.orderByStatement, fn)
+		Walk(&n.limitStatement, fn)
+		Walk(&n.fetchStatement, fn)
+	case *InsertBuilder:
+		Walk(&n.insertStatement, fn)
+		Walk(&n.rowStatement, fn)
+		Walk(&n.queryStatement, fn)
+	case *UpdateBuilder:
+		Walk(&n.updateStatement, fn)
+		Walk(&n.setStatement, fn)
+		Walk(&n.fromStatement, fn)
+		Walk(&n.joinStatement, fn)
+		Walk(&n.whereStatement, fn)
+		Walk(&n.orderByStatement, fn)
+		Walk(&n.limitStatement, fn)
+	case *DeleteBuilder:
+		Walk(&n.deleteStatement, fn)
+
+		// The USING tables are visited as FROM clauses, a table written by fn is kept
+		for i := range n.usingStatement {
+			using := From{Table: n.usingStatement[i]}
+			Walk(&using, fn)
+
+			if table, ok := using.Table.(string); ok {
+				n.usingStatement[i] = table
+			}
+		}
+
+		Walk(&n.joinStatement, fn)
+		Walk(&n.whereStatement, fn)
+		Walk(&n.orderByStatement, fn)
+		Walk(&n.limitStatement, fn)
+	case *Select:
+		walkValues(n.Columns, fn)
+	case *From:
+		walkValue(n.Table, fn)
+	case *Join:
+		for i := range n.Items {
+			Walk(&n.Items[i], fn)
+		}
+	case *JoinItem:
+		if n.Join != CrossJoin {
+			Walk(&n.Condition, fn)
+		}
+	case *Where:
+		walkConditions(n.Conditions, fn)
+	case *Having:
+		walkConditions(n.Conditions, fn)
+	case *Condition:
+		walkValue(n.Field, fn)
+		walkValue(n.Value, fn)
+		walkConditions(n.Group, fn)
+	case *InsertRows:
+		for i := range n.Rows {
+			Walk(&n.Rows[i], fn)
+		}
+	case *InsertRow:
+		walkValues(n.Values, fn)
+	case *InsertQuery:
+		walkValue(n.Query, fn)
+	case *Update:
+		walkValue(n.Table, fn)
+	case *UpdateSet:
+		for i := range n.Items {
+			Walk(&n.Items[i], fn)
+		}
+	case *UpdateItem:
+		walkValue(n.Field, fn)
+		walkValue(n.Value, fn)
+	case *Delete:
+		walkValue(n.Table, fn)
+	case Expression:
+		walkValues(n.Args, fn)
+	case ValueBetween:
+		walkValue(n.Low, fn)
+		walkValue(n.High, fn)
+	case *Case:
+		for i := range n.WhenClauses {
+			Walk(&n.WhenClauses[i], fn)
+		}
+	case *WhenCase:
+		switch conditions := n.Conditions.(type) {
+		case []Condition:
+			walkConditions(conditions, fn)
+		case Expression:
+			Walk(conditions, fn)
+		}
+
+		walkValue(n.Value, fn)
+	}
+}
+
+// walkConditions walks each condition of a list.
+//
+// Parameters:
+//   - conditions ([]Condition): The conditions, given to fn by pointer.
+//   - fn (func(Node) bool): The visitor.
+func walkConditions(conditions []Condition, fn func(Node) bool) {
+	for i := range conditions {
+		Walk(&conditions[i], fn)
+	}
+}
+
+// walkValues walks the nodes of a list of values.
+//
+// Parameters:
+//   - values ([]any): The columns, row values or expression arguments.
+//   - fn (func(Node) bool): The visitor.
+func walkValues(values []any, fn func(Node) bool) {
+	for _, value := range values {
+		walkValue(value, fn)
+	}
+}
+
+// walkValue walks a value if it is a subquery, a CASE or a raw expression, or the items of a []any value.
+//
+// Parameters:
+//   - value (any): The column, table, field or value.
+//   - fn (func(Node) bool): The visitor.
+func walkValue(value any, fn func(Node) bool) {
+	switch v := value.(type) {
+	case *QueryBuilder:
+		if v != nil {
+			Walk(v, fn)
+		}
+	case *Case:
+		if v != nil {
+			Walk(v, fn)
+		}
+	case Expression:
+		Walk(v, fn)
+	case ValueBetween:
+		Walk(v, fn)
+	case []any:
+		walkValues(v, fn)
+	}
+}
@@ -0,0 +1,135 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// walkTrace lists the types of the nodes visited by Walk, the empty clauses excluded.
+func walkTrace(node Node) string {
+	var trace []string
+
+	Walk(node, func(n Node) bool {
+		if n.String() != "" {
+			trace = append(trace, strings.TrimPrefix(strings.TrimPrefix(fmt.Sprintf("%T", n), "*"), "fluentsql."))
+		}
+
+		return true
+	})
+
+	return strings.Join(trace, " ")
+}
+
+// TestWalk
+func TestWalk(t *testing.T) {
+	paid := QueryInstance().Select("user_id").From("orders").Where("status", Eq, "paid")
+
+	testCases := map[string]Node{
+		"QueryBuilder Select From Join JoinItem Condition Where Condition ValueBetween Condition QueryBuilder Select From Where Condition Limit": QueryInstance().
+			Select("id").
+			From("users", "u").
+			Join(LeftJoin, "profiles p", Condition{Field: "p.user_id", Opt: Eq, Value: ValueField("u.id")}).
+			Where("u.age", Between, ValueBetween{Low: 18, High: 65}).
+			Where("u.id", In, paid).
+			Limit(10, 0),
+		"QueryBuilder Select Case WhenCase Condition From": QueryInstance().
+			Select(FieldCase("", "size").When([]Condition{{Field: "qty", Opt: Greater, Value: 10}}, "large")).
+			From("items"),
+		"InsertBuilder Insert InsertRows InsertRow InsertRow": InsertInstance().
+			Insert("users", "name").
+			Row("John").
+			Row("Jane"),
+		"UpdateBuilder Update UpdateSet UpdateItem UpdateItem Expression Where Condition": UpdateInstance().
+			Update("users").
+			Set("name", "John").
+			SetExpr("visits", Expr("visits + ?", 1)).
+			Where("id", Eq, 1),
+		"UpdateBuilder Update UpdateSet UpdateItem Expression Where Condition QueryBuilder Select From": UpdateInstance().
+			Update("summary").
+			Set([]string{"total", "visits"}, []any{0, Expr("visits + ?", 1)}).
+			Where("id", In, []any{1, QueryInstance().Select("id").From("drafts")}),
+		"DeleteBuilder Delete From Where Condition": DeleteInstance().
+			Delete("orders", "o").
+			Using("customers c").
+			Where("c.id", Eq, ValueField("o.customer_id")),
+		"DeleteBuilder Delete Where Condition Condition Condition": DeleteInstance().
+			Delete("sessions").
+			WhereGroup(func(whereBuilder WhereBuilder) *WhereBuilder {
+				whereBuilder.Where("expired", Eq, true).WhereOr("revoked", Eq, true)
+
+				return &whereBuilder
+			}),
+	}
+
+	for expected, node := range testCases {
+		if trace := walkTrace(node); trace != expected {
+			t.Fatalf(`Trace %s != %s`, trace, expected)
+		}
+	}
+}
+
+// TestWalkSkip
+func TestWalkSkip(t *testing.T) {
+	query := QueryInstance().
+		Select("*").
+		From(QueryInstance().Select("id").From("orders").Where("tenant_id", Eq, 1), "o").
+		Where("status", Eq, "paid")
+
+	var fields []any
+
+	Walk(query, func(node Node) bool {
+		if condition, ok := node.(*Condition); ok {
+			fields = append(fields, condition.Field)
+		}
+
+		// Do not enter the subqueries
+		_, isQuery := node.(*QueryBuilder)
+
+		return !isQuery || node == query
+	})
+
+	if fmt.Sprint(fields) != "[status]" {
+		t.Fatalf(`Fields %v != [status]`, fields)
+	}
+}
+
+// TestWalkRewrite
+func TestWalkRewrite(t *testing.T) {
+	query := QueryInstance().
+		Select("*").
+		From("orders").
+		Where("id", In, QueryInstance().Select("order_id").From("orders").Where("total", Greater, 100))
+
+	// Add the tenant filter to every SELECT reading orders
+	Walk(query, func(node Node) bool {
+		if qb, ok := node.(*QueryBuilder); ok && qb.FromClause().Table == "orders" {
+			qb.WhereClause().Append(Condition{Field: "tenant_id", Opt: Eq, Value: 7})
+		}
+
+		return true
+	})
+
+	sql, args, _ := query.Sql()
+
+	expected := "SELECT * FROM orders WHERE id IN (SELECT order_id FROM orders WHERE total > $1 AND tenant_id = $2) AND tenant_id = $3"
+	if sql != expected || len(args) != 3 {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	// Rename the USING tables
+	del := DeleteInstance().Delete("orders", "o").Using("customers c").Where("c.id", Eq, ValueField("o.customer_id"))
+
+	Walk(del, func(node Node) bool {
+		if from, ok := node.(*From); ok && from.Table == "customers c" {
+			from.Table = "archive.customers c"
+		}
+
+		return true
+	})
+
+	expected = "DELETE FROM orders o USING archive.customers c WHERE c.id = o.customer_id"
+	if del.String() != expected {
+		t.Fatalf(`Query %s != %s`, del.String(), expected)
+	}
+}
@@ -2,8 +2,6 @@ package fluentsql
 
 import (
 	"fmt"
-	"reflect"
-	"strings"
 )
 
 // Where clause
@@ -26,6 +24,24 @@ func (w *Where) Append(conditions ...Condition) {
 	w.Conditions = append(w.Conditions, conditions...)
 }
 
+// grouped returns the conditions wrapped in a group when one of them is joined with OR, so conditions
+// added with AND on either side keep applying to all the rows.
+//
+// Parameters:
+//   - conditions ([]Condition): The conditions of a WHERE clause.
+//
+// Returns:
+//   - []Condition: The conditions, a single group if one of them is joined with OR.
+func grouped(conditions []Condition) []Condition {
+	for _, condition := range conditions {
+		if condition.AndOr == Or {
+			return []Condition{{Group: conditions}}
+		}
+	}
+
+	return conditions
+}
+
 // String generates and returns the SQL representation of the WHERE clause.
 //
 // Returns:
@@ -40,35 +56,7 @@ func (w *Where) Append(conditions ...Condition) {
 //	  WHERE clause representation of conditions will be formatted as:
 //		 WHERE condition1 AND condition2 OR condition3
 func (w *Where) String() string {
-	var conditions []string
-
-	// Loop through each Condition in the Conditions slice.
-	if len(w.Conditions) > 0 {
-		for _, cond := range w.Conditions {
-			// Convert the current condition to its string representation.
-			var _condition = cond.String()
-
-			// If the operator is OR, combine it with the previous condition.
-			if cond.AndOr == Or && len(conditions) > 0 {
-				_orCondition := fmt.Sprint(" OR ", _condition)
-
-				// Modify the last condition by appending the OR condition.
-				last := len(conditions) - 1
-				conditions[last] += _orCondition
-			} else {
-				// Append the current condition to the slice.
-				conditions = append(conditions, _condition)
-			}
-		}
-	}
-
-	// No WHERE condition
-	if len(conditions) == 0 {
-		return ""
-	}
-
-	// Join all conditions with "AND" and return the final WHERE clause.
-	return fmt.Sprintf("WHERE %s", strings.Join(conditions, " AND "))
+	return inlineString(w)
 }
 
 // Condition type struct
@@ -206,95 +194,7 @@ func (c *Condition) opt() string {
 // Returns:
 //   - string: A SQL string representation of the condition.
 func (c *Condition) String() string {
-	// Handle group conditions WhereGroup(groupCondition FnWhereBuilder)
-	if len(c.Group) > 0 {
-		var conditions []string
-
-		// Iterate over the grouped conditions.
-		for _, cond := range c.Group {
-			// Generate the SQL string representation for each condition in the group.
-			var _condition = cond.String()
-
-			// If the logical operator is OR, append the condition with "OR".
-			if cond.AndOr == Or && len(conditions) > 0 {
-				_orCondition := fmt.Sprint(" OR ", _condition)
-
-				// Update the last condition with the "OR" combination.
-				last := len(conditions) - 1
-				conditions[last] += _orCondition
-			} else {
-				// Append the condition to the list.
-				conditions = append(conditions, _condition)
-			}
-		}
-
-		// Return an empty string if no conditions are present in the group.
-		if len(conditions) == 0 {
-			return ""
-		}
-
-		// Join the group conditions with "AND" and enclose them in parentheses.
-		return fmt.Sprintf("(%s)", strings.Join(conditions, " AND "))
-	}
-
-	// Handle IS NULL and IS NOT NULL conditions.
-	// Example: WHERE Address IS NULL or WHERE Address IS NOT NULL
-	if c.Opt == Null || c.Opt == NotNull {
-		return fmt.Sprintf("%s %s", c.Field, c.opt())
-	}
-
-	// Handle IN and NOT IN conditions.
-	// Example: WHERE Country IN ('Germany', 'France', 'UK')
-	// Example: WHERE Age NOT IN (12, 31, 21)
-	if c.Opt == In || c.Opt == NotIn {
-		// Determine the type of the value being compared (e.g., slice or array).
-		typ := reflect.TypeOf(c.Value)
-
-		if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
-			valuesStr := ""
-			// Convert slice of string values to SQL string format.
-			if values, ok := c.Value.([]string); ok {
-				valuesStr = "'" + strings.Join(values, "', '") + "'"
-			}
-			// Convert slice of integer values to comma-separated string format.
-			if values, ok := c.Value.([]int); ok {
-				valuesStr = joinSlice(values, ",")
-			}
-
-			// Generate the SQL representation.
-			return fmt.Sprintf("%s %s (%s)", c.Field, c.opt(), valuesStr)
-		}
-	}
-
-	// WHERE Price BETWEEN 10 AND 20;
-	// WHERE ProductName BETWEEN 'Carnation Tigers' AND 'Mozzarella di Giovanni'
-	// WHERE Price NOT BETWEEN 10 AND 20;
-	// WHERE ProductName NOT BETWEEN 'Carnation Tigers' AND 'Mozzarella di Giovanni'
-	// WHERE Price BETWEEN 10 AND 20
-	if c.Opt == Between || c.Opt == NotBetween {
-		return fmt.Sprintf("%s %s %v", c.Field, c.opt(), c.Value)
-	}
-
-	// WHERE salary = (SELECT DISTINCT salary FROM employees ORDER BY salary DESC LIMIT 1 , 1);
-	// WHERE CustomerID IN (SELECT CustomerID FROM Orders);
-	// WHERE CustomerID NOT IN (SELECT CustomerID FROM Orders);
-	// WHERE EXISTS (SELECT ProductName FROM Products);
-	// WHERE NOT EXISTS (SELECT ProductName FROM Products);
-	// WHERE ProductID = ANY (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
-	// WHERE ProductID > ALL (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
-	if valueQueryBuilder, ok := c.Value.(*QueryBuilder); ok { // Column type is a complex query.
-		return fmt.Sprintf("%s %s (%v)", c.Field, c.opt(), valueQueryBuilder)
-	}
-
-	// Handle string value conditions.
-	// Example: WHERE Name = 'John'
-	if valueString, ok := c.Value.(string); ok {
-		return fmt.Sprintf("%s %s '%v'", c.Field, c.opt(), valueString)
-	}
-
-	// Default case: Handle simple field-value conditions.
-	// Example: WHERE Age > 30
-	return fmt.Sprintf("%s %s %v", c.Field, c.opt(), c.Value)
+	return inlineString(c)
 }
 
 type WhereAndOr int
@@ -323,15 +223,7 @@ type ValueBetween struct {
 //   - If Low = 1999 and High = 2000, it returns "1999 AND 2000"
 //   - If Low = "1999-01-01" and High = "2000-12-31", it returns "'1999-01-01' AND '2000-12-31'"
 func (v ValueBetween) String() string {
-	if _, ok := v.Low.(string); ok {
-		// If bounds are strings, include single quotes around them.
-		// Eg: hire_date BETWEEN '1999-01-01' AND '2000-12-31'
-		return fmt.Sprintf("'%v' AND '%v'", v.Low, v.High)
-	}
-
-	// Return the range as is for non-string bounds.
-	// Eg: salary NOT BETWEEN 2500 AND 2900
-	return fmt.Sprintf("%v AND %v", v.Low, v.High)
+	return inlineString(v)
 }
 
 // ValueField represents a column/field in a SQL query as a string value.
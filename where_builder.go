@@ -12,6 +12,17 @@ func WhereInstance() *WhereBuilder {
 	return &WhereBuilder{}
 }
 
+// Clone returns a deep copy of the WhereBuilder instance, including the condition groups.
+// Returns:
+//   - *WhereBuilder: A new instance of WhereBuilder.
+func (wb *WhereBuilder) Clone() *WhereBuilder {
+	if wb == nil {
+		return nil
+	}
+
+	return &WhereBuilder{whereStatement: wb.whereStatement.clone()}
+}
+
 // Where builder
 // Adds a new condition to the WHERE clause with an AND operator.
 //